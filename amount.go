@@ -0,0 +1,332 @@
+package jupiter
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Amount is a raw token amount in the smallest unit of its mint, such as
+// lamports for SOL. Values that fit in a uint64 are stored inline, so
+// Amounts can be compared with ==; larger values fall back to big.Int. It
+// marshals to the decimal string used by the API and also accepts JSON
+// numbers.
+type Amount struct {
+	n   uint64
+	big *big.Int
+}
+
+var ErrInvalidAmount = errors.New("invalid amount")
+
+func NewAmount(n uint64) Amount {
+	return Amount{n: n}
+}
+
+func NewAmountFromBig(n *big.Int) (Amount, error) {
+	if n.Sign() < 0 {
+		return Amount{}, fmt.Errorf("%w: %s is negative", ErrInvalidAmount, n)
+	}
+	if n.IsUint64() {
+		return Amount{n: n.Uint64()}, nil
+	}
+	return Amount{big: new(big.Int).Set(n)}, nil
+}
+
+func ParseAmount(s string) (Amount, error) {
+	if n, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return Amount{n: n}, nil
+	}
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok || strings.HasPrefix(s, "+") {
+		return Amount{}, fmt.Errorf("%w %q", ErrInvalidAmount, s)
+	}
+	return NewAmountFromBig(n)
+}
+
+// Big returns the amount as a new big.Int.
+func (a Amount) Big() *big.Int {
+	if a.big != nil {
+		return new(big.Int).Set(a.big)
+	}
+	return new(big.Int).SetUint64(a.n)
+}
+
+// Uint64 returns the amount and whether it fits in a uint64.
+func (a Amount) Uint64() (uint64, bool) {
+	return a.n, a.big == nil
+}
+
+func (a Amount) IsZero() bool {
+	return a.big == nil && a.n == 0
+}
+
+func (a Amount) Cmp(b Amount) int {
+	if a.big == nil && b.big == nil {
+		switch {
+		case a.n < b.n:
+			return -1
+		case a.n > b.n:
+			return 1
+		}
+		return 0
+	}
+	return a.Big().Cmp(b.Big())
+}
+
+func (a Amount) Add(b Amount) Amount {
+	if a.big == nil && b.big == nil && a.n <= math.MaxUint64-b.n {
+		return Amount{n: a.n + b.n}
+	}
+	sum, _ := NewAmountFromBig(new(big.Int).Add(a.Big(), b.Big()))
+	return sum
+}
+
+func (a Amount) String() string {
+	if a.big != nil {
+		return a.big.String()
+	}
+	return strconv.FormatUint(a.n, 10)
+}
+
+// UIAmount converts the raw amount into whole tokens, e.g. 1500000 with 6
+// decimals is 1.5. It fails when decimals is out of range.
+func (a Amount) UIAmount(decimals int) (Decimal, error) {
+	if err := checkDecimals(decimals); err != nil {
+		return Decimal{}, err
+	}
+	return Decimal{unscaled: a.Big(), scale: decimals}.normalize(), nil
+}
+
+// number returns a as a JSON number, for endpoints that take raw amounts as
+// numbers rather than strings.
+func (a Amount) number() json.Number {
+	return json.Number(a.String())
+}
+
+func (a Amount) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+func (a *Amount) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*a = Amount{}
+		return nil
+	}
+	amount, err := ParseAmount(string(text))
+	if err != nil {
+		return err
+	}
+	*a = amount
+	return nil
+}
+
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	return a.UnmarshalText(bytes.Trim(data, `"`))
+}
+
+// Decimal is an exact decimal number, used for UI amounts and prices the
+// API sends as JSON numbers. It marshals to a JSON number and accepts both
+// numbers and strings.
+type Decimal struct {
+	unscaled *big.Int
+	scale    int
+}
+
+var ErrInvalidDecimal = errors.New("invalid decimal")
+
+// maxDecimalExponent bounds exponents and token decimals, and
+// maxDecimalDigits the significant digits of a parsed decimal, so that
+// untrusted input cannot force huge powers of ten or long normalization
+// loops.
+const (
+	maxDecimalExponent = 100
+	maxDecimalDigits   = 256
+)
+
+func checkDecimals(decimals int) error {
+	if decimals < 0 || decimals > maxDecimalExponent {
+		return fmt.Errorf("%w: %d decimals out of range", ErrInvalidAmount, decimals)
+	}
+	return nil
+}
+
+func ParseDecimal(s string) (Decimal, error) {
+	mantissa, exponent := s, 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		exp, err := strconv.Atoi(s[i+1:])
+		if err != nil {
+			return Decimal{}, fmt.Errorf("%w %q", ErrInvalidDecimal, s)
+		}
+		if exp > maxDecimalExponent || exp < -maxDecimalExponent {
+			return Decimal{}, fmt.Errorf("%w %q: exponent out of range", ErrInvalidDecimal, s)
+		}
+		mantissa, exponent = s[:i], exp
+	}
+	whole, frac, _ := strings.Cut(mantissa, ".")
+	digits := whole + frac
+	if digits == "" || digits == "-" || digits == "+" || strings.ContainsAny(digits[1:], "+-") {
+		return Decimal{}, fmt.Errorf("%w %q", ErrInvalidDecimal, s)
+	}
+	// trailing fractional zeros are dropped as text; stripping them from
+	// the big.Int one at a time is quadratic
+	frac = strings.TrimRight(frac, "0")
+	digits = whole + frac
+	if digits == "" || digits == "-" || digits == "+" {
+		digits += "0"
+	}
+	if len(strings.TrimLeft(digits, "+-")) > maxDecimalDigits {
+		return Decimal{}, fmt.Errorf("%w %q: more than %d digits", ErrInvalidDecimal, s, maxDecimalDigits)
+	}
+	unscaled, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("%w %q", ErrInvalidDecimal, s)
+	}
+	d := Decimal{unscaled: unscaled, scale: len(frac) - exponent}
+	if d.scale < 0 {
+		d.unscaled.Mul(d.unscaled, pow10(-d.scale))
+		d.scale = 0
+	}
+	return d.normalize(), nil
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// normalize drops trailing zeros after the decimal point.
+func (d Decimal) normalize() Decimal {
+	if d.unscaled == nil {
+		return d
+	}
+	if d.unscaled.Sign() == 0 {
+		return Decimal{unscaled: d.unscaled}
+	}
+	ten := big.NewInt(10)
+	rem := new(big.Int)
+	for d.scale > 0 {
+		quo, r := new(big.Int).QuoRem(d.unscaled, ten, rem)
+		if r.Sign() != 0 {
+			break
+		}
+		d.unscaled = quo
+		d.scale--
+	}
+	return d
+}
+
+func (d Decimal) IsZero() bool {
+	return d.unscaled == nil || d.unscaled.Sign() == 0
+}
+
+// Rat returns the exact value as a new big.Rat.
+func (d Decimal) Rat() *big.Rat {
+	if d.unscaled == nil {
+		return new(big.Rat)
+	}
+	return new(big.Rat).SetFrac(d.unscaled, pow10(d.scale))
+}
+
+// Float64 returns the nearest float64, for display and other uses where
+// rounding is acceptable.
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+	return f
+}
+
+func (d Decimal) Cmp(other Decimal) int {
+	return d.Rat().Cmp(other.Rat())
+}
+
+// Amount converts whole tokens back into a raw amount. It fails when d is
+// negative or has more fractional digits than decimals allows.
+func (d Decimal) Amount(decimals int) (Amount, error) {
+	if err := checkDecimals(decimals); err != nil {
+		return Amount{}, err
+	}
+	d = d.normalize()
+	if d.scale > decimals {
+		return Amount{}, fmt.Errorf("%w: %s has more than %d decimals", ErrInvalidAmount, d, decimals)
+	}
+	raw := new(big.Int)
+	if d.unscaled != nil {
+		raw.Mul(d.unscaled, pow10(decimals-d.scale))
+	}
+	return NewAmountFromBig(raw)
+}
+
+func (d Decimal) String() string {
+	if d.unscaled == nil {
+		return "0"
+	}
+	digits := new(big.Int).Abs(d.unscaled).String()
+	sign := ""
+	if d.unscaled.Sign() < 0 {
+		sign = "-"
+	}
+	if d.scale <= 0 {
+		return sign + digits
+	}
+	if len(digits) <= d.scale {
+		digits = strings.Repeat("0", d.scale-len(digits)+1) + digits
+	}
+	point := len(digits) - d.scale
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	text := bytes.Trim(data, `"`)
+	if len(text) == 0 {
+		*d = Decimal{}
+		return nil
+	}
+	decimal, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	*d = decimal
+	return nil
+}
+
+// Bps is a value in basis points; 10000 bps is 100%.
+type Bps uint16
+
+const MaxBps Bps = 10000
+
+func (b Bps) Valid() bool {
+	return b <= MaxBps
+}
+
+// Decimal returns b as a fraction, e.g. 50 bps is 0.005.
+func (b Bps) Decimal() Decimal {
+	return Decimal{unscaled: big.NewInt(int64(b)), scale: 4}.normalize()
+}
+
+// UIAmount converts a raw amount of this token into whole tokens.
+func (t TokenV2) UIAmount(amount Amount) (Decimal, error) {
+	return amount.UIAmount(t.Decimals)
+}
+
+// ParseUIAmount converts whole tokens, such as "1.5", into a raw amount of
+// this token.
+func (t TokenV2) ParseUIAmount(s string) (Amount, error) {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		return Amount{}, err
+	}
+	return d.Amount(t.Decimals)
+}
@@ -0,0 +1,285 @@
+package jupiter
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		fits    bool
+		wantErr bool
+	}{
+		{in: "0", want: "0", fits: true},
+		{in: "1000000000", want: "1000000000", fits: true},
+		{in: "18446744073709551615", want: "18446744073709551615", fits: true},
+		{in: "18446744073709551616", want: "18446744073709551616"},
+		{in: "340282366920938463463374607431768211455", want: "340282366920938463463374607431768211455"},
+		{in: "-1", wantErr: true},
+		{in: "+1", wantErr: true},
+		{in: "1.5", wantErr: true},
+		{in: "abc", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			amount, err := ParseAmount(tt.in)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidAmount) {
+					t.Fatalf("expected ErrInvalidAmount, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if amount.String() != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, amount)
+			}
+			if _, ok := amount.Uint64(); ok != tt.fits {
+				t.Errorf("expected Uint64 ok=%t, got %t", tt.fits, ok)
+			}
+			if amount.Big().String() != tt.want {
+				t.Errorf("expected big %s, got %s", tt.want, amount.Big())
+			}
+		})
+	}
+}
+
+func TestAmount_Arithmetic(t *testing.T) {
+	max := NewAmount(1<<64 - 1)
+	sum := max.Add(NewAmount(1))
+	if sum.String() != "18446744073709551616" {
+		t.Errorf("expected overflow into big.Int, got %s", sum)
+	}
+	if sum.Cmp(max) != 1 || max.Cmp(sum) != -1 || max.Cmp(NewAmount(1<<64-1)) != 0 {
+		t.Error("unexpected Cmp result")
+	}
+	if NewAmount(1) != NewAmount(1) {
+		t.Error("expected small amounts to compare equal with ==")
+	}
+	if !NewAmount(0).IsZero() || sum.IsZero() {
+		t.Error("unexpected IsZero result")
+	}
+	if _, err := NewAmountFromBig(big.NewInt(-5)); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("expected ErrInvalidAmount for negative big.Int, got %v", err)
+	}
+}
+
+func TestAmount_JSON(t *testing.T) {
+	var v struct {
+		A Amount `json:"a"`
+		B Amount `json:"b"`
+		C Amount `json:"c"`
+		D Amount `json:"d"`
+	}
+	data := `{"a":"1000000","b":250,"c":"","d":null}`
+	if err := json.Unmarshal([]byte(data), &v); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v.A != NewAmount(1000000) || v.B != NewAmount(250) || !v.C.IsZero() || !v.D.IsZero() {
+		t.Errorf("unexpected decode result: %+v", v)
+	}
+
+	out, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(out) != `{"a":"1000000","b":"250","c":"0","d":"0"}` {
+		t.Errorf("unexpected encoding: %s", out)
+	}
+
+	if err := json.Unmarshal([]byte(`{"a":"-1"}`), &v); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("expected ErrInvalidAmount, got %v", err)
+	}
+}
+
+func TestAmount_UIAmount(t *testing.T) {
+	tests := []struct {
+		amount   string
+		decimals int
+		want     string
+	}{
+		{"1500000", 6, "1.5"},
+		{"1", 9, "0.000000001"},
+		{"1000000000", 9, "1"},
+		{"0", 6, "0"},
+		{"123", 0, "123"},
+		{"340282366920938463463374607431768211455", 9, "340282366920938463463374607431.768211455"},
+	}
+	for _, tt := range tests {
+		amount, err := ParseAmount(tt.amount)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got, err := amount.UIAmount(tt.decimals)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got.String() != tt.want {
+			t.Errorf("UIAmount(%s, %d): expected %s, got %s", tt.amount, tt.decimals, tt.want, got)
+		}
+		back, err := got.Amount(tt.decimals)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if back.Cmp(amount) != 0 {
+			t.Errorf("round trip of %s: got %s", tt.amount, back)
+		}
+	}
+}
+
+func TestTokenV2_UIAmount(t *testing.T) {
+	usdc := TokenV2{Symbol: "USDC", Decimals: 6}
+
+	if got, err := usdc.UIAmount(NewAmount(2500000)); err != nil || got.String() != "2.5" {
+		t.Errorf("expected 2.5, got %s (%v)", got, err)
+	}
+	for _, decimals := range []int{-1, 1 << 30} {
+		if _, err := (TokenV2{Decimals: decimals}).UIAmount(NewAmount(1)); !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("expected ErrInvalidAmount for %d decimals, got %v", decimals, err)
+		}
+	}
+
+	amount, err := usdc.ParseUIAmount("0.1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if amount != NewAmount(100000) {
+		t.Errorf("expected 100000, got %s", amount)
+	}
+
+	_, err = usdc.ParseUIAmount("0.0000001")
+	if !errors.Is(err, ErrInvalidAmount) || !strings.Contains(err.Error(), "more than 6 decimals") {
+		t.Errorf("expected too many decimals error, got %v", err)
+	}
+	if _, err := usdc.ParseUIAmount("-1"); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("expected ErrInvalidAmount for negative amount, got %v", err)
+	}
+	if _, err := usdc.ParseUIAmount("1.2.3"); !errors.Is(err, ErrInvalidDecimal) {
+		t.Errorf("expected ErrInvalidDecimal, got %v", err)
+	}
+	if _, err := usdc.ParseUIAmount("1e-20000000"); !errors.Is(err, ErrInvalidDecimal) {
+		t.Errorf("expected ErrInvalidDecimal for huge exponent, got %v", err)
+	}
+	if _, err := (TokenV2{Decimals: 1 << 30}).ParseUIAmount("1"); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("expected ErrInvalidAmount for out of range decimals, got %v", err)
+	}
+}
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "150.25", want: "150.25"},
+		{in: "1.0", want: "1"},
+		{in: "0.000100", want: "0.0001"},
+		{in: "-2.50", want: "-2.5"},
+		{in: ".5", want: "0.5"},
+		{in: "1e3", want: "1000"},
+		{in: "1.5E-7", want: "0.00000015"},
+		{in: "0.1", want: "0.1"},
+		{in: "0e-100", want: "0"},
+		{in: ".000", want: "0"},
+		{in: "-0.0", want: "0"},
+		{in: strings.Repeat("1", 256), want: strings.Repeat("1", 256)},
+		{in: "2.5e-100", want: "0." + strings.Repeat("0", 99) + "25"},
+		{in: "", wantErr: true},
+		{in: "-", wantErr: true},
+		{in: "1-2", wantErr: true},
+		{in: "1e", wantErr: true},
+		{in: "abc", wantErr: true},
+		{in: "0e-20000000", wantErr: true},
+		{in: "1e2000000", wantErr: true},
+		{in: "1e101", wantErr: true},
+		{in: "+", wantErr: true},
+		{in: strings.Repeat("1", 257), wantErr: true},
+		{in: "0." + strings.Repeat("1", 300), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			d, err := ParseDecimal(tt.in)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidDecimal) {
+					t.Fatalf("expected ErrInvalidDecimal, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if d.String() != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, d)
+			}
+		})
+	}
+}
+
+func TestDecimal_LongTrailingZeros(t *testing.T) {
+	var entry PriceV3Entry
+	data := `{"usdPrice":"1.` + strings.Repeat("0", 200000) + `"}`
+	if err := json.Unmarshal([]byte(data), &entry); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if entry.USDPrice.String() != "1" {
+		t.Errorf("expected 1, got %s", entry.USDPrice)
+	}
+}
+
+func TestDecimal_JSON(t *testing.T) {
+	var v struct {
+		Number Decimal  `json:"number"`
+		String Decimal  `json:"string"`
+		Ptr    *Decimal `json:"ptr,omitempty"`
+	}
+	if err := json.Unmarshal([]byte(`{"number":0.1,"string":"123456789.123456789"}`), &v); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v.Number.String() != "0.1" || v.String.String() != "123456789.123456789" || v.Ptr != nil {
+		t.Errorf("unexpected decode result: %s %s %v", v.Number, v.String, v.Ptr)
+	}
+	if v.Number.Cmp(testDecimal("0.10")) != 0 {
+		t.Error("expected 0.1 to equal 0.10")
+	}
+	if v.Number.Float64() != 0.1 {
+		t.Errorf("expected float 0.1, got %v", v.Number.Float64())
+	}
+
+	out, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(out) != `{"number":0.1,"string":123456789.123456789}` {
+		t.Errorf("unexpected encoding: %s", out)
+	}
+
+	if err := json.Unmarshal([]byte(`{"number":0e-20000000}`), &v); !errors.Is(err, ErrInvalidDecimal) {
+		t.Errorf("expected ErrInvalidDecimal for huge exponent, got %v", err)
+	}
+}
+
+func TestBps(t *testing.T) {
+	if got := Bps(50).Decimal(); got.String() != "0.005" {
+		t.Errorf("expected 0.005, got %s", got)
+	}
+	if got := MaxBps.Decimal(); got.String() != "1" {
+		t.Errorf("expected 1, got %s", got)
+	}
+	if !MaxBps.Valid() || Bps(10001).Valid() {
+		t.Error("unexpected Valid result")
+	}
+
+	out, err := json.Marshal(CreateOrderParams{MakingAmount: NewAmount(1), TakingAmount: NewAmount(2), SlippageBps: 50})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(out) != `{"makingAmount":"1","takingAmount":"2","slippageBps":"50"}` {
+		t.Errorf("unexpected encoding: %s", out)
+	}
+}
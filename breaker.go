@@ -0,0 +1,245 @@
+package jupiter
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type CircuitState int
+
+const (
+	CircuitClosed CircuitState = iota
+	CircuitOpen
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	}
+	return fmt.Sprintf("CircuitState(%d)", int(s))
+}
+
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitOpenError is returned without contacting the API while the breaker
+// of an endpoint family is open. It matches ErrCircuitOpen with errors.Is.
+type CircuitOpenError struct {
+	Family  EndpointFamily
+	RetryAt time.Time
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("%v for %s endpoints until %s", ErrCircuitOpen, e.Family, e.RetryAt.Format(time.RFC3339))
+}
+
+func (e *CircuitOpenError) Is(target error) bool {
+	return target == ErrCircuitOpen
+}
+
+// BreakerConfig configures the breakers. The failure rate is computed over
+// the last WindowSize outcomes once at least MinRequests were recorded.
+// Transport errors and 5xx responses count as failures.
+type BreakerConfig struct {
+	FailureRateThreshold float64
+	MinRequests          int
+	WindowSize           int
+	CoolDown             time.Duration
+	HalfOpenRequests     int
+	OnStateChange        func(family EndpointFamily, from, to CircuitState)
+}
+
+func DefaultBreakerConfig() BreakerConfig {
+	return BreakerConfig{
+		FailureRateThreshold: 0.5,
+		MinRequests:          10,
+		WindowSize:           20,
+		CoolDown:             30 * time.Second,
+		HalfOpenRequests:     1,
+	}
+}
+
+type breaker struct {
+	state    CircuitState
+	outcomes []bool
+	openedAt time.Time
+	probes   int
+}
+
+// CircuitBreakers holds one breaker per endpoint family.
+type CircuitBreakers struct {
+	config   BreakerConfig
+	mu       sync.Mutex
+	breakers map[EndpointFamily]*breaker
+}
+
+func NewCircuitBreakers(config BreakerConfig) *CircuitBreakers {
+	defaults := DefaultBreakerConfig()
+	if config.FailureRateThreshold <= 0 {
+		config.FailureRateThreshold = defaults.FailureRateThreshold
+	}
+	if config.WindowSize <= 0 {
+		config.WindowSize = defaults.WindowSize
+	}
+	if config.MinRequests <= 0 {
+		config.MinRequests = min(defaults.MinRequests, config.WindowSize)
+	}
+	if config.CoolDown <= 0 {
+		config.CoolDown = defaults.CoolDown
+	}
+	if config.HalfOpenRequests <= 0 {
+		config.HalfOpenRequests = defaults.HalfOpenRequests
+	}
+	return &CircuitBreakers{
+		config:   config,
+		breakers: map[EndpointFamily]*breaker{},
+	}
+}
+
+func (cb *CircuitBreakers) State(family EndpointFamily) CircuitState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if b, ok := cb.breakers[family]; ok {
+		return b.state
+	}
+	return CircuitClosed
+}
+
+func (cb *CircuitBreakers) breaker(family EndpointFamily) *breaker {
+	b, ok := cb.breakers[family]
+	if !ok {
+		b = &breaker{}
+		cb.breakers[family] = b
+	}
+	return b
+}
+
+// allow reports whether a call may proceed, moving an open breaker to
+// half-open once its cool-down has elapsed.
+func (cb *CircuitBreakers) allow(family EndpointFamily) error {
+	cb.mu.Lock()
+	b := cb.breaker(family)
+	from := b.state
+	if b.state == CircuitOpen {
+		retryAt := b.openedAt.Add(cb.config.CoolDown)
+		if time.Now().Before(retryAt) {
+			cb.mu.Unlock()
+			return &CircuitOpenError{Family: family, RetryAt: retryAt}
+		}
+		b.state = CircuitHalfOpen
+		b.probes = 0
+	}
+	if b.state == CircuitHalfOpen {
+		if b.probes >= cb.config.HalfOpenRequests {
+			cb.mu.Unlock()
+			return &CircuitOpenError{Family: family, RetryAt: time.Now().Add(cb.config.CoolDown)}
+		}
+		b.probes++
+	}
+	to := b.state
+	cb.mu.Unlock()
+
+	cb.notify(family, from, to)
+	return nil
+}
+
+// release gives back a half-open probe whose outcome is unknown.
+func (cb *CircuitBreakers) release(family EndpointFamily) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if b := cb.breaker(family); b.state == CircuitHalfOpen && b.probes > 0 {
+		b.probes--
+	}
+}
+
+func (cb *CircuitBreakers) record(family EndpointFamily, success bool) {
+	cb.mu.Lock()
+	b := cb.breaker(family)
+	from := b.state
+
+	switch b.state {
+	case CircuitHalfOpen:
+		if success {
+			b.state = CircuitClosed
+			b.outcomes = nil
+		} else {
+			b.state = CircuitOpen
+			b.openedAt = time.Now()
+		}
+	case CircuitClosed:
+		b.outcomes = append(b.outcomes, success)
+		if len(b.outcomes) > cb.config.WindowSize {
+			b.outcomes = b.outcomes[len(b.outcomes)-cb.config.WindowSize:]
+		}
+		if len(b.outcomes) >= cb.config.MinRequests {
+			failures := 0
+			for _, ok := range b.outcomes {
+				if !ok {
+					failures++
+				}
+			}
+			if float64(failures)/float64(len(b.outcomes)) >= cb.config.FailureRateThreshold {
+				b.state = CircuitOpen
+				b.openedAt = time.Now()
+				b.outcomes = nil
+			}
+		}
+	}
+	to := b.state
+	cb.mu.Unlock()
+
+	cb.notify(family, from, to)
+}
+
+func (cb *CircuitBreakers) notify(family EndpointFamily, from, to CircuitState) {
+	if from != to && cb.config.OnStateChange != nil {
+		cb.config.OnStateChange(family, from, to)
+	}
+}
+
+// CircuitBreakerMiddleware fails calls fast with a *CircuitOpenError while
+// the breaker of their endpoint family is open.
+func CircuitBreakerMiddleware(breakers *CircuitBreakers) Middleware {
+	return func(next Handler) Handler {
+		return func(call *Call) (*http.Response, error) {
+			family := EndpointFamilyOf(call.Endpoint)
+			if err := breakers.allow(family); err != nil {
+				return nil, err
+			}
+
+			httpResponse, err := next(call)
+
+			var (
+				apiErr *APIError
+				tErr   *transportError
+			)
+			switch {
+			case call.Request.Context().Err() != nil:
+				breakers.release(family)
+			case errors.As(err, &tErr):
+				breakers.record(family, false)
+			case errors.As(err, &apiErr):
+				breakers.record(family, apiErr.StatusCode < http.StatusInternalServerError)
+			case httpResponse != nil:
+				breakers.record(family, true)
+			default:
+				breakers.release(family)
+			}
+			return httpResponse, err
+		}
+	}
+}
+
+func WithCircuitBreaker(config BreakerConfig) Option {
+	return func(c *Client) {
+		c.Breakers = NewCircuitBreakers(config)
+	}
+}
@@ -0,0 +1,155 @@
+package jupiter
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker_OpensAndFailsFast(t *testing.T) {
+	var calls atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	client := newTestClient(server.URL)
+	WithCircuitBreaker(BreakerConfig{MinRequests: 4, WindowSize: 4, CoolDown: time.Hour})(client)
+
+	for i := 0; i < 4; i++ {
+		_, err := client.GetPrices(context.Background(), "SOL")
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) {
+			t.Fatalf("call %d: expected APIError, got %v", i, err)
+		}
+	}
+	if state := client.Breakers.State(FamilyPrice); state != CircuitOpen {
+		t.Fatalf("expected price breaker open, got %s", state)
+	}
+
+	_, err := client.GetPrices(context.Background(), "SOL")
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+	var openErr *CircuitOpenError
+	if !errors.As(err, &openErr) || openErr.Family != FamilyPrice {
+		t.Errorf("expected CircuitOpenError for price, got %v", err)
+	}
+	if calls.Load() != 4 {
+		t.Errorf("expected 4 calls to reach the server, got %d", calls.Load())
+	}
+	if state := client.Breakers.State(FamilySwap); state != CircuitClosed {
+		t.Errorf("expected swap breaker to stay closed, got %s", state)
+	}
+}
+
+func TestCircuitBreaker_ClientErrorsDoNotTrip(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusBadRequest, "bad request"))
+	client := newTestClient(server.URL)
+	WithCircuitBreaker(BreakerConfig{MinRequests: 2, WindowSize: 2})(client)
+
+	for i := 0; i < 5; i++ {
+		client.GetPrices(context.Background(), "SOL")
+	}
+	if state := client.Breakers.State(FamilyPrice); state != CircuitClosed {
+		t.Errorf("expected breaker closed after 4xx responses, got %s", state)
+	}
+}
+
+func TestCircuitBreaker_HalfOpenRecovery(t *testing.T) {
+	var healthy atomic.Bool
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if !healthy.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	})
+
+	var (
+		mu          sync.Mutex
+		transitions []string
+	)
+	client := newTestClient(server.URL)
+	WithCircuitBreaker(BreakerConfig{
+		MinRequests: 1,
+		WindowSize:  1,
+		CoolDown:    20 * time.Millisecond,
+		OnStateChange: func(family EndpointFamily, from, to CircuitState) {
+			mu.Lock()
+			defer mu.Unlock()
+			transitions = append(transitions, string(family)+":"+from.String()+"->"+to.String())
+		},
+	})(client)
+
+	client.GetPrices(context.Background(), "SOL")
+	time.Sleep(30 * time.Millisecond)
+
+	// the probe fails, so the breaker opens again
+	client.GetPrices(context.Background(), "SOL")
+	_, err := client.GetPrices(context.Background(), "SOL")
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen after failed probe, got %v", err)
+	}
+
+	healthy.Store(true)
+	time.Sleep(30 * time.Millisecond)
+	_, err = client.GetPrices(context.Background(), "SOL")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if state := client.Breakers.State(FamilyPrice); state != CircuitClosed {
+		t.Errorf("expected breaker closed after successful probe, got %s", state)
+	}
+
+	want := []string{
+		"price:closed->open",
+		"price:open->half-open",
+		"price:half-open->open",
+		"price:open->half-open",
+		"price:half-open->closed",
+	}
+	if len(transitions) != len(want) {
+		t.Fatalf("expected transitions %v, got %v", want, transitions)
+	}
+	for i := range want {
+		if transitions[i] != want[i] {
+			t.Fatalf("expected transitions %v, got %v", want, transitions)
+		}
+	}
+}
+
+func TestCircuitBreaker_HalfOpenLimitsProbes(t *testing.T) {
+	breakers := NewCircuitBreakers(BreakerConfig{MinRequests: 1, WindowSize: 1, CoolDown: time.Millisecond})
+	breakers.record(FamilyUltra, false)
+	time.Sleep(2 * time.Millisecond)
+
+	if err := breakers.allow(FamilyUltra); err != nil {
+		t.Fatalf("expected first probe to be allowed, got %v", err)
+	}
+	if err := breakers.allow(FamilyUltra); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("expected second probe to be rejected, got %v", err)
+	}
+	breakers.release(FamilyUltra)
+	if err := breakers.allow(FamilyUltra); err != nil {
+		t.Fatalf("expected released probe slot to be reusable, got %v", err)
+	}
+}
+
+func TestCircuitState_String(t *testing.T) {
+	tests := map[CircuitState]string{
+		CircuitClosed:   "closed",
+		CircuitOpen:     "open",
+		CircuitHalfOpen: "half-open",
+		CircuitState(9): "CircuitState(9)",
+	}
+	for state, want := range tests {
+		if got := state.String(); got != want {
+			t.Errorf("String() = %q, want %q", got, want)
+		}
+	}
+}
@@ -1,17 +1,164 @@
 package jupiter
 
-import "fmt"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrRateLimited         = errors.New("rate limited")
+	ErrUnauthorized        = errors.New("unauthorized")
+	ErrNoRoute             = errors.New("no route found")
+	ErrInsufficientBalance = errors.New("insufficient balance")
+	ErrTokenNotTradable    = errors.New("token not tradable")
+	ErrOrderNotFound       = errors.New("order not found")
+)
 
 // APIError represents an HTTP error response from the Jupiter API.
 // It preserves the original status code and raw response body,
 // allowing callers to forward the error as-is.
+//
+// When the body is a JSON object its error, errorCode, code and message
+// fields are decoded into ErrorText, ErrorCode, Code and Message. Numeric
+// error codes are kept as their decimal text. APIError matches the Err*
+// sentinels of this package with errors.Is.
 type APIError struct {
 	StatusCode int
 	RawBody    []byte
 	Method     string
 	URL        string
+	// BaseURL is the base URL that answered when failover is enabled.
+	BaseURL string
+
+	ErrorText string
+	ErrorCode string
+	Code      int
+	Message   string
 }
 
 func (e *APIError) Error() string {
-	return fmt.Sprintf("call %s() on %s status code: %d", e.Method, e.URL, e.StatusCode)
-}
\ No newline at end of file
+	msg := fmt.Sprintf("call %s() on %s status code: %d", e.Method, e.URL, e.StatusCode)
+	if detail := e.detail(); detail != "" {
+		msg += ": " + detail
+	}
+	return msg
+}
+
+func (e *APIError) detail() string {
+	switch {
+	case e.ErrorText != "" && e.ErrorCode != "":
+		return fmt.Sprintf("%s (%s)", e.ErrorText, e.ErrorCode)
+	case e.ErrorText != "":
+		return e.ErrorText
+	case e.Message != "":
+		return e.Message
+	}
+	return e.ErrorCode
+}
+
+// apiErrorKinds maps sentinels to the error codes and message fragments
+// Jupiter uses for them. Messages are compared case-insensitively.
+var apiErrorKinds = []struct {
+	err       error
+	codes     []string
+	fragments []string
+}{
+	{ErrRateLimited, []string{"RATE_LIMITED", "TOO_MANY_REQUESTS"}, []string{"rate limit", "too many requests"}},
+	{ErrUnauthorized, []string{"UNAUTHORIZED", "INVALID_API_KEY"}, []string{"unauthorized", "invalid api key"}},
+	{ErrNoRoute, []string{"COULD_NOT_FIND_ANY_ROUTE", "NO_ROUTES_FOUND", "ROUTE_PLAN_DOES_NOT_CONSUME_ALL_THE_AMOUNT"}, []string{"could not find any route", "no routes found", "no route found"}},
+	{ErrInsufficientBalance, []string{"INSUFFICIENT_BALANCE", "INSUFFICIENT_FUNDS"}, []string{"insufficient balance", "insufficient funds", "insufficient lamports"}},
+	{ErrTokenNotTradable, []string{"TOKEN_NOT_TRADABLE"}, []string{"not tradable"}},
+	{ErrOrderNotFound, []string{"ORDER_NOT_FOUND"}, []string{"order not found"}},
+}
+
+func (e *APIError) Is(target error) bool {
+	switch {
+	case target == ErrRateLimited && (e.StatusCode == http.StatusTooManyRequests || e.Code == http.StatusTooManyRequests):
+		return true
+	case target == ErrUnauthorized && (e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden):
+		return true
+	}
+	text := strings.ToLower(e.ErrorText + "\n" + e.Message)
+	for _, kind := range apiErrorKinds {
+		if kind.err != target {
+			continue
+		}
+		if slices.Contains(kind.codes, strings.ToUpper(e.ErrorCode)) {
+			return true
+		}
+		for _, fragment := range kind.fragments {
+			if strings.Contains(text, fragment) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Retryable reports whether the same request may succeed if sent again
+// later: rate limits, timeouts and server errors other than 501.
+func (e *APIError) Retryable() bool {
+	switch e.StatusCode {
+	case http.StatusTooManyRequests, http.StatusRequestTimeout:
+		return true
+	case http.StatusNotImplemented:
+		return false
+	}
+	return e.StatusCode >= http.StatusInternalServerError
+}
+
+// decodeBody fills the structured fields from RawBody. Bodies that are not
+// JSON objects leave them empty.
+func (e *APIError) decodeBody() {
+	var body struct {
+		Error     json.RawMessage `json:"error"`
+		ErrorCode json.RawMessage `json:"errorCode"`
+		Code      json.RawMessage `json:"code"`
+		Message   json.RawMessage `json:"message"`
+	}
+	if json.Unmarshal(e.RawBody, &body) != nil {
+		return
+	}
+	e.ErrorText = jsonText(body.Error)
+	e.ErrorCode = jsonText(body.ErrorCode)
+	e.Message = jsonText(body.Message)
+	e.Code, _ = strconv.Atoi(jsonText(body.Code))
+}
+
+// jsonText returns a JSON string or number as text; objects, arrays and
+// null yield "".
+func jsonText(raw json.RawMessage) string {
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return s
+	}
+	var n json.Number
+	if json.Unmarshal(raw, &n) == nil {
+		return n.String()
+	}
+	return ""
+}
+
+var ErrResponseTooLarge = errors.New("response body too large")
+
+// ResponseTooLargeError is returned when a successful response body exceeds
+// the client's maximum response size. It matches ErrResponseTooLarge with
+// errors.Is.
+type ResponseTooLargeError struct {
+	Limit  int64
+	Method string
+	URL    string
+}
+
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("call %s() on %s: response body exceeds %d bytes", e.Method, e.URL, e.Limit)
+}
+
+func (e *ResponseTooLargeError) Is(target error) bool {
+	return target == ErrResponseTooLarge
+}
@@ -0,0 +1,147 @@
+package jupiter
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestAPIError_DecodesCapturedPayloads(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		body      string
+		errorText string
+		errorCode string
+		code      int
+		message   string
+		sentinel  error
+		retryable bool
+	}{
+		{
+			name:      "swap no route",
+			status:    http.StatusBadRequest,
+			body:      `{"error":"Could not find any route","errorCode":"COULD_NOT_FIND_ANY_ROUTE"}`,
+			errorText: "Could not find any route",
+			errorCode: "COULD_NOT_FIND_ANY_ROUTE",
+			sentinel:  ErrNoRoute,
+		},
+		{
+			name:      "swap token not tradable",
+			status:    http.StatusBadRequest,
+			body:      `{"error":"The token EPjF... is not tradable","errorCode":"TOKEN_NOT_TRADABLE"}`,
+			errorText: "The token EPjF... is not tradable",
+			errorCode: "TOKEN_NOT_TRADABLE",
+			sentinel:  ErrTokenNotTradable,
+		},
+		{
+			name:      "ultra insufficient funds",
+			status:    http.StatusBadRequest,
+			body:      `{"error":"Insufficient funds","errorCode":1}`,
+			errorText: "Insufficient funds",
+			errorCode: "1",
+			sentinel:  ErrInsufficientBalance,
+		},
+		{
+			name:      "trigger order not found",
+			status:    http.StatusNotFound,
+			body:      `{"error":"Order not found","cause":"no order with this public key","code":404}`,
+			errorText: "Order not found",
+			code:      404,
+			sentinel:  ErrOrderNotFound,
+		},
+		{
+			name:      "rate limited",
+			status:    http.StatusTooManyRequests,
+			body:      `{"code":429,"message":"Rate limit exceeded"}`,
+			code:      429,
+			message:   "Rate limit exceeded",
+			sentinel:  ErrRateLimited,
+			retryable: true,
+		},
+		{
+			name:     "unauthorized",
+			status:   http.StatusUnauthorized,
+			body:     `{"code":"401","message":"Invalid API key"}`,
+			code:     401,
+			message:  "Invalid API key",
+			sentinel: ErrUnauthorized,
+		},
+		{
+			name:      "plain text server error",
+			status:    http.StatusBadGateway,
+			body:      `upstream connect error`,
+			retryable: true,
+		},
+		{
+			name:      "not implemented",
+			status:    http.StatusNotImplemented,
+			body:      `{"error":"not implemented"}`,
+			errorText: "not implemented",
+		},
+	}
+
+	sentinels := []error{ErrRateLimited, ErrUnauthorized, ErrNoRoute, ErrInsufficientBalance, ErrTokenNotTradable, ErrOrderNotFound}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newTestServer(t, errorHandler(tt.status, tt.body))
+			client := newTestClient(server.URL)
+
+			req := NewRequest(client.Url("/test"), nil)
+			var response map[string]any
+			_, err := client.doCall(context.Background(), req, &response)
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected APIError, got %v", err)
+			}
+			if apiErr.ErrorText != tt.errorText {
+				t.Errorf("ErrorText = %q, want %q", apiErr.ErrorText, tt.errorText)
+			}
+			if apiErr.ErrorCode != tt.errorCode {
+				t.Errorf("ErrorCode = %q, want %q", apiErr.ErrorCode, tt.errorCode)
+			}
+			if apiErr.Code != tt.code {
+				t.Errorf("Code = %d, want %d", apiErr.Code, tt.code)
+			}
+			if apiErr.Message != tt.message {
+				t.Errorf("Message = %q, want %q", apiErr.Message, tt.message)
+			}
+			if apiErr.Retryable() != tt.retryable {
+				t.Errorf("Retryable() = %t, want %t", apiErr.Retryable(), tt.retryable)
+			}
+			for _, sentinel := range sentinels {
+				if got, want := errors.Is(err, sentinel), sentinel == tt.sentinel; got != want {
+					t.Errorf("errors.Is(err, %v) = %t, want %t", sentinel, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIError_Error(t *testing.T) {
+	tests := []struct {
+		err  *APIError
+		want string
+	}{
+		{
+			&APIError{Method: "GET", URL: "https://api.jup.ag/swap/v1/quote", StatusCode: 400},
+			"call GET() on https://api.jup.ag/swap/v1/quote status code: 400",
+		},
+		{
+			&APIError{Method: "GET", URL: "/q", StatusCode: 400, ErrorText: "Could not find any route", ErrorCode: "COULD_NOT_FIND_ANY_ROUTE"},
+			"call GET() on /q status code: 400: Could not find any route (COULD_NOT_FIND_ANY_ROUTE)",
+		},
+		{
+			&APIError{Method: "POST", URL: "/x", StatusCode: 429, Message: "Rate limit exceeded"},
+			"call POST() on /x status code: 429: Rate limit exceeded",
+		},
+	}
+	for _, tt := range tests {
+		if got := tt.err.Error(); got != tt.want {
+			t.Errorf("Error() = %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package jupiter
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ExecuteCode is the code returned by the Ultra and Trigger execute
+// endpoints. Zero means success; negative codes come from the execute
+// service and positive ones from the swap program.
+type ExecuteCode int
+
+const (
+	ExecuteSuccess ExecuteCode = 0
+
+	ExecuteMissingCachedOrder     ExecuteCode = -1
+	ExecuteInvalidSignedTx        ExecuteCode = -2
+	ExecuteInvalidMessageBytes    ExecuteCode = -3
+	ExecuteMissingRequestID       ExecuteCode = -4
+	ExecuteMissingSignedTx        ExecuteCode = -5
+	ExecuteFailedToLand           ExecuteCode = -1000
+	ExecuteUnknownError           ExecuteCode = -1001
+	ExecuteInvalidTransaction     ExecuteCode = -1002
+	ExecuteTxNotFullySigned       ExecuteCode = -1003
+	ExecuteInvalidBlockHeight     ExecuteCode = -1004
+	ExecuteExpired                ExecuteCode = -1005
+	ExecuteTimedOut               ExecuteCode = -1006
+	ExecuteGaslessUnsupported     ExecuteCode = -1007
+	ExecuteRFQFailedToLand        ExecuteCode = -2000
+	ExecuteRFQUnknownError        ExecuteCode = -2001
+	ExecuteRFQInvalidPayload      ExecuteCode = -2002
+	ExecuteRFQQuoteExpired        ExecuteCode = -2003
+	ExecuteRFQSwapRejected        ExecuteCode = -2004
+	ExecuteSlippageExceeded       ExecuteCode = 6001
+	ExecuteNotEnoughAccountKeys   ExecuteCode = 6008
+	ExecuteIncorrectTokenProgram  ExecuteCode = 6014
+	ExecuteExactOutAmountMismatch ExecuteCode = 6017
+	ExecuteInsufficientFunds      ExecuteCode = 6024
+)
+
+// ExecuteAction tells what to do with an order whose execution failed.
+type ExecuteAction int
+
+const (
+	// ExecuteNoAction is the action of a successful execution.
+	ExecuteNoAction ExecuteAction = iota
+	// ExecuteRequote means the transaction did not land and a fresh order
+	// or quote should be requested and signed.
+	ExecuteRequote
+	// ExecuteResign means the same order can be signed and submitted again.
+	ExecuteResign
+	// ExecuteCheckStatus means the transaction may still land. Check the
+	// status of its signature before retrying, or the swap may run twice.
+	ExecuteCheckStatus
+	// ExecuteAbandon means retrying will not help without changing the
+	// request, the wallet or its balances.
+	ExecuteAbandon
+)
+
+func (a ExecuteAction) String() string {
+	switch a {
+	case ExecuteNoAction:
+		return "none"
+	case ExecuteRequote:
+		return "requote"
+	case ExecuteResign:
+		return "resign"
+	case ExecuteCheckStatus:
+		return "check status"
+	case ExecuteAbandon:
+		return "abandon"
+	}
+	return fmt.Sprintf("ExecuteAction(%d)", int(a))
+}
+
+var executeCodes = map[ExecuteCode]struct {
+	description string
+	action      ExecuteAction
+}{
+	ExecuteSuccess:                {"success", ExecuteNoAction},
+	ExecuteMissingCachedOrder:     {"order not found or expired", ExecuteRequote},
+	ExecuteInvalidSignedTx:        {"invalid signed transaction", ExecuteResign},
+	ExecuteInvalidMessageBytes:    {"transaction message was modified", ExecuteResign},
+	ExecuteMissingRequestID:       {"missing request id", ExecuteAbandon},
+	ExecuteMissingSignedTx:        {"missing signed transaction", ExecuteAbandon},
+	ExecuteFailedToLand:           {"transaction not landed", ExecuteRequote},
+	ExecuteUnknownError:           {"unknown error", ExecuteCheckStatus},
+	ExecuteInvalidTransaction:     {"invalid transaction", ExecuteRequote},
+	ExecuteTxNotFullySigned:       {"transaction not fully signed", ExecuteResign},
+	ExecuteInvalidBlockHeight:     {"blockhash expired", ExecuteRequote},
+	ExecuteExpired:                {"order expired", ExecuteRequote},
+	ExecuteTimedOut:               {"timed out", ExecuteCheckStatus},
+	ExecuteGaslessUnsupported:     {"gasless not supported for this wallet", ExecuteAbandon},
+	ExecuteRFQFailedToLand:        {"RFQ transaction not landed", ExecuteRequote},
+	ExecuteRFQUnknownError:        {"RFQ unknown error", ExecuteCheckStatus},
+	ExecuteRFQInvalidPayload:      {"RFQ invalid payload", ExecuteRequote},
+	ExecuteRFQQuoteExpired:        {"RFQ quote expired", ExecuteRequote},
+	ExecuteRFQSwapRejected:        {"RFQ swap rejected", ExecuteRequote},
+	ExecuteSlippageExceeded:       {"slippage tolerance exceeded", ExecuteRequote},
+	ExecuteNotEnoughAccountKeys:   {"not enough account keys", ExecuteAbandon},
+	ExecuteIncorrectTokenProgram:  {"incorrect token program id", ExecuteAbandon},
+	ExecuteExactOutAmountMismatch: {"exact out amount not matched", ExecuteRequote},
+	ExecuteInsufficientFunds:      {"insufficient funds", ExecuteAbandon},
+}
+
+func (c ExecuteCode) String() string {
+	if info, ok := executeCodes[c]; ok {
+		return info.description
+	}
+	return fmt.Sprintf("ExecuteCode(%d)", int(c))
+}
+
+// Action returns what to do after a failure with this code. Unknown codes
+// need a status check, since the transaction may still have landed.
+func (c ExecuteCode) Action() ExecuteAction {
+	if info, ok := executeCodes[c]; ok {
+		return info.action
+	}
+	return ExecuteCheckStatus
+}
+
+var ErrExecuteFailed = errors.New("execute failed")
+
+// ExecuteError describes a failed execution reported in an ExecuteResponse.
+// It matches ErrExecuteFailed with errors.Is.
+type ExecuteError struct {
+	Code      ExecuteCode
+	Status    string
+	Message   string
+	Signature string
+	Action    ExecuteAction
+}
+
+func (e *ExecuteError) Error() string {
+	msg := fmt.Sprintf("%v with code %d (%s)", ErrExecuteFailed, int(e.Code), e.Code)
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+func (e *ExecuteError) Is(target error) bool {
+	return target == ErrExecuteFailed
+}
+
+// Err returns nil when the execution succeeded and an *ExecuteError
+// otherwise.
+func (r *ExecuteResponse) Err() error {
+	code := ExecuteSuccess
+	if r.Code != nil {
+		code = ExecuteCode(*r.Code)
+	}
+	if r.Status == "Success" && code == ExecuteSuccess {
+		return nil
+	}
+	if code == ExecuteSuccess {
+		code = ExecuteUnknownError
+	}
+	return &ExecuteError{
+		Code:      code,
+		Status:    r.Status,
+		Message:   r.Error,
+		Signature: r.Signature,
+		Action:    code.Action(),
+	}
+}
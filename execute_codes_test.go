@@ -0,0 +1,101 @@
+package jupiter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestExecuteResponse_Err(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+
+	tests := []struct {
+		name   string
+		resp   ExecuteResponse
+		code   ExecuteCode
+		action ExecuteAction
+		ok     bool
+	}{
+		{"success", ExecuteResponse{Status: "Success", Signature: "sig", Code: intPtr(0)}, 0, 0, true},
+		{"success without code", ExecuteResponse{Status: "Success", Signature: "sig"}, 0, 0, true},
+		{"slippage", ExecuteResponse{Status: "Failed", Error: "slippage", Code: intPtr(6001)}, ExecuteSlippageExceeded, ExecuteRequote, false},
+		{"expired blockhash", ExecuteResponse{Status: "Failed", Code: intPtr(-1004)}, ExecuteInvalidBlockHeight, ExecuteRequote, false},
+		{"not landed", ExecuteResponse{Status: "Failed", Code: intPtr(-1000)}, ExecuteFailedToLand, ExecuteRequote, false},
+		{"invalid signature", ExecuteResponse{Status: "Failed", Code: intPtr(-2)}, ExecuteInvalidSignedTx, ExecuteResign, false},
+		{"not fully signed", ExecuteResponse{Status: "Failed", Code: intPtr(-1003)}, ExecuteTxNotFullySigned, ExecuteResign, false},
+		{"insufficient funds", ExecuteResponse{Status: "Failed", Code: intPtr(6024)}, ExecuteInsufficientFunds, ExecuteAbandon, false},
+		{"missing request id", ExecuteResponse{Status: "Failed", Code: intPtr(-4)}, ExecuteMissingRequestID, ExecuteAbandon, false},
+		{"timed out", ExecuteResponse{Status: "Failed", Code: intPtr(-1006)}, ExecuteTimedOut, ExecuteCheckStatus, false},
+		{"failed without code", ExecuteResponse{Status: "Failed", Error: "boom"}, ExecuteUnknownError, ExecuteCheckStatus, false},
+		{"undocumented code", ExecuteResponse{Status: "Failed", Code: intPtr(1001)}, 1001, ExecuteCheckStatus, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.resp.Err()
+			if tt.ok {
+				if err != nil {
+					t.Fatalf("expected nil error, got %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrExecuteFailed) {
+				t.Fatalf("expected ErrExecuteFailed, got %v", err)
+			}
+			var execErr *ExecuteError
+			if !errors.As(err, &execErr) {
+				t.Fatalf("expected ExecuteError, got %T", err)
+			}
+			if execErr.Code != tt.code {
+				t.Errorf("Code = %d, want %d", execErr.Code, tt.code)
+			}
+			if execErr.Action != tt.action {
+				t.Errorf("Action = %s, want %s", execErr.Action, tt.action)
+			}
+			if execErr.Message != tt.resp.Error {
+				t.Errorf("Message = %q, want %q", execErr.Message, tt.resp.Error)
+			}
+		})
+	}
+}
+
+func TestExecuteError_Error(t *testing.T) {
+	err := &ExecuteError{Code: ExecuteSlippageExceeded, Message: "Slippage tolerance exceeded"}
+	want := "execute failed with code 6001 (slippage tolerance exceeded): Slippage tolerance exceeded"
+	if err.Error() != want {
+		t.Errorf("Error() = %q, want %q", err.Error(), want)
+	}
+	if got := ExecuteCode(42).String(); got != "ExecuteCode(42)" {
+		t.Errorf("String() = %q, want ExecuteCode(42)", got)
+	}
+	if got := ExecuteSuccess.Action(); got != ExecuteNoAction {
+		t.Errorf("ExecuteSuccess.Action() = %s, want %s", got, ExecuteNoAction)
+	}
+	var zero ExecuteAction
+	if zero == ExecuteRequote || zero.String() != "none" {
+		t.Errorf("expected the zero ExecuteAction to be none, got %s", zero)
+	}
+}
+
+func TestExecuteUltra_FailedResponseErr(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status":"Failed","signature":"sig-1","error":"Transaction expired","code":-1005}`))
+	})
+	client := newTestClient(server.URL)
+
+	result, err := client.ExecuteUltra(context.Background(), ExecuteRequest{SignedTransaction: "tx", RequestID: "req"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var execErr *ExecuteError
+	if !errors.As(result.Err(), &execErr) {
+		t.Fatalf("expected ExecuteError, got %v", result.Err())
+	}
+	if execErr.Code != ExecuteExpired || execErr.Action != ExecuteRequote || execErr.Signature != "sig-1" {
+		b, _ := json.Marshal(execErr)
+		t.Errorf("unexpected execute error %s", b)
+	}
+}
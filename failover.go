@@ -0,0 +1,314 @@
+package jupiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+const DefaultFailoverCoolDown = 30 * time.Second
+
+// BaseURLHealth reports the health of one base URL for an endpoint family.
+type BaseURLHealth struct {
+	URL            string
+	Healthy        bool
+	Failures       int
+	LastFailure    time.Time
+	UnhealthyUntil time.Time
+}
+
+type baseURLState struct {
+	failures       int
+	lastFailure    time.Time
+	unhealthyUntil time.Time
+}
+
+// BaseURL is a server Failover can send calls to.
+type BaseURL struct {
+	URL string
+	// Prefixes replaces endpoint path prefixes for servers that mount the
+	// API elsewhere. The longest matching prefix wins; {"/swap/v1": ""}
+	// sends "/swap/v1/quote" to "/quote".
+	Prefixes map[string]string
+	// SelfHosted marks a server outside the api.jup.ag plan. Calls to it
+	// skip the client's rate limiter and API key.
+	SelfHosted bool
+}
+
+// SelfHostedSwapAPI returns the BaseURL of an open-source Jupiter swap API
+// server, which serves /quote, /swap and /swap-instructions at its root.
+func SelfHostedSwapAPI(url string) BaseURL {
+	return BaseURL{
+		URL:        url,
+		Prefixes:   map[string]string{"/swap/v1": ""},
+		SelfHosted: true,
+	}
+}
+
+// path returns the path of endpoint on this server.
+func (b BaseURL) path(endpoint string) string {
+	match := ""
+	for prefix := range b.Prefixes {
+		if len(prefix) <= len(match) || !strings.HasPrefix(endpoint, prefix) {
+			continue
+		}
+		if rest := endpoint[len(prefix):]; rest == "" || rest[0] == '/' {
+			match = prefix
+		}
+	}
+	if match == "" {
+		return endpoint
+	}
+	return b.Prefixes[match] + endpoint[len(match):]
+}
+
+// Failover holds an ordered list of base URLs per endpoint family. Families
+// without an entry in Families use Default. A base URL that fails with a
+// connection error or a 5xx response is skipped for CoolDown; when every
+// base URL of a family is unhealthy they are still tried, soonest to
+// recover first.
+type Failover struct {
+	Default  []BaseURL
+	Families map[EndpointFamily][]BaseURL
+	CoolDown time.Duration
+
+	mu     sync.Mutex
+	health map[EndpointFamily]map[string]*baseURLState
+}
+
+func NewFailover(defaults []BaseURL, families map[EndpointFamily][]BaseURL) *Failover {
+	return &Failover{
+		Default:  defaults,
+		Families: families,
+		CoolDown: DefaultFailoverCoolDown,
+		health:   map[EndpointFamily]map[string]*baseURLState{},
+	}
+}
+
+func (f *Failover) configured(family EndpointFamily) []BaseURL {
+	if urls, ok := f.Families[family]; ok && len(urls) > 0 {
+		return urls
+	}
+	return f.Default
+}
+
+func (f *Failover) state(family EndpointFamily, baseURL string) *baseURLState {
+	states, ok := f.health[family]
+	if !ok {
+		states = map[string]*baseURLState{}
+		f.health[family] = states
+	}
+	s, ok := states[baseURL]
+	if !ok {
+		s = &baseURLState{}
+		states[baseURL] = s
+	}
+	return s
+}
+
+// BaseURLs returns the base URLs of family in the order they will be
+// tried: healthy ones in configured order, then unhealthy ones.
+func (f *Failover) BaseURLs(family EndpointFamily) []BaseURL {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now()
+	var healthy, unhealthy []BaseURL
+	for _, baseURL := range f.configured(family) {
+		if now.Before(f.state(family, baseURL.URL).unhealthyUntil) {
+			unhealthy = append(unhealthy, baseURL)
+		} else {
+			healthy = append(healthy, baseURL)
+		}
+	}
+	slices.SortStableFunc(unhealthy, func(a, b BaseURL) int {
+		return f.state(family, a.URL).unhealthyUntil.Compare(f.state(family, b.URL).unhealthyUntil)
+	})
+	return append(healthy, unhealthy...)
+}
+
+func (f *Failover) Health(family EndpointFamily) []BaseURLHealth {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	now := time.Now()
+	var health []BaseURLHealth
+	for _, baseURL := range f.configured(family) {
+		s := f.state(family, baseURL.URL)
+		health = append(health, BaseURLHealth{
+			URL:            baseURL.URL,
+			Healthy:        !now.Before(s.unhealthyUntil),
+			Failures:       s.failures,
+			LastFailure:    s.lastFailure,
+			UnhealthyUntil: s.unhealthyUntil,
+		})
+	}
+	return health
+}
+
+func (f *Failover) record(family EndpointFamily, baseURL string, success bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	s := f.state(family, baseURL)
+	if success {
+		*s = baseURLState{}
+		return
+	}
+	coolDown := f.CoolDown
+	if coolDown <= 0 {
+		coolDown = DefaultFailoverCoolDown
+	}
+	s.failures++
+	s.lastFailure = time.Now()
+	s.unhealthyUntil = s.lastFailure.Add(coolDown)
+}
+
+// CallInfo collects details about calls made with a context returned by
+// ContextWithCallInfo.
+type CallInfo struct {
+	mu      sync.Mutex
+	baseURL string
+}
+
+type callInfoKey struct{}
+
+func ContextWithCallInfo(ctx context.Context) (context.Context, *CallInfo) {
+	info := &CallInfo{}
+	return context.WithValue(ctx, callInfoKey{}, info), info
+}
+
+// BaseURL returns the base URL that served the last attempt.
+func (i *CallInfo) BaseURL() string {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.baseURL
+}
+
+func (i *CallInfo) setBaseURL(baseURL string) {
+	if i == nil {
+		return
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.baseURL = baseURL
+}
+
+func callInfoFrom(ctx context.Context) *CallInfo {
+	info, _ := ctx.Value(callInfoKey{}).(*CallInfo)
+	return info
+}
+
+// FailoverMiddleware sends each call to the base URLs of its endpoint family
+// in turn until one answers without a connection error or a 5xx. Calls that
+// are not idempotent only move on when the request never reached the
+// server. The base URL used is stored in APIError.BaseURL, in a
+// *BaseURLError wrapping connection errors and in the CallInfo of the
+// context.
+func FailoverMiddleware(failover *Failover) Middleware {
+	return func(next Handler) Handler {
+		return func(call *Call) (*http.Response, error) {
+			family := EndpointFamilyOf(call.Endpoint)
+			baseURLs := failover.BaseURLs(family)
+			if len(baseURLs) == 0 {
+				return next(call)
+			}
+
+			var (
+				httpResponse *http.Response
+				err          error
+			)
+			for _, baseURL := range baseURLs {
+				attempt, reqErr := withBaseURL(call, baseURL)
+				if reqErr != nil {
+					return nil, reqErr
+				}
+				callInfoFrom(call.Request.Context()).setBaseURL(baseURL.URL)
+
+				httpResponse, err = next(attempt)
+
+				var (
+					apiErr *APIError
+					tErr   *transportError
+				)
+				switch {
+				case call.Request.Context().Err() != nil:
+					return httpResponse, err
+				case errors.As(err, &tErr):
+					failover.record(family, baseURL.URL, false)
+					err = &BaseURLError{BaseURL: baseURL.URL, Err: err}
+					if tErr.written && !call.Idempotent {
+						return httpResponse, err
+					}
+				case errors.As(err, &apiErr):
+					apiErr.BaseURL = baseURL.URL
+					if apiErr.StatusCode < http.StatusInternalServerError {
+						failover.record(family, baseURL.URL, true)
+						return httpResponse, err
+					}
+					failover.record(family, baseURL.URL, false)
+					if !call.Idempotent {
+						return httpResponse, err
+					}
+				case err == nil:
+					failover.record(family, baseURL.URL, true)
+					return httpResponse, nil
+				default:
+					return httpResponse, err
+				}
+			}
+			return httpResponse, err
+		}
+	}
+}
+
+// BaseURLError wraps a connection error with the base URL the failed
+// request was sent to.
+type BaseURLError struct {
+	BaseURL string
+	Err     error
+}
+
+func (e *BaseURLError) Error() string {
+	return fmt.Sprintf("%v (base URL %s)", e.Err, e.BaseURL)
+}
+
+func (e *BaseURLError) Unwrap() error {
+	return e.Err
+}
+
+// withBaseURL returns a copy of call whose request targets baseURL.
+func withBaseURL(call *Call, baseURL BaseURL) (*Call, error) {
+	target, err := url.Parse(strings.TrimSuffix(baseURL.URL, "/") + baseURL.path(call.Endpoint))
+	if err != nil {
+		return nil, err
+	}
+	target.RawQuery = call.Request.URL.RawQuery
+
+	httpRequest := call.Request.Clone(call.Request.Context())
+	httpRequest.URL = target
+	httpRequest.Host = ""
+	if httpRequest.GetBody != nil {
+		if httpRequest.Body, err = httpRequest.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+
+	attempt := *call
+	attempt.Request = httpRequest
+	attempt.SelfHosted = baseURL.SelfHosted
+	return &attempt, nil
+}
+
+// WithFailover sends calls to the given base URLs instead of ApiUrl.
+func WithFailover(defaults []BaseURL, families map[EndpointFamily][]BaseURL) Option {
+	return func(c *Client) {
+		c.Failover = NewFailover(defaults, families)
+	}
+}
@@ -0,0 +1,252 @@
+package jupiter
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestFailover_SwitchesOn5xx(t *testing.T) {
+	var primaryCalls atomic.Int32
+	primary := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		primaryCalls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	fallback := newTestServer(t, jsonHandler(t, http.MethodGet, "/price/v3", PriceV3Response{}))
+
+	client := newTestClient("https://unused.invalid")
+	WithFailover([]BaseURL{{URL: primary.URL}, {URL: fallback.URL}}, nil)(client)
+
+	ctx, info := ContextWithCallInfo(context.Background())
+	if _, err := client.GetPrices(ctx, "SOL"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.BaseURL() != fallback.URL {
+		t.Errorf("expected base URL %s, got %s", fallback.URL, info.BaseURL())
+	}
+
+	health := client.Failover.Health(FamilyPrice)
+	if health[0].Healthy || health[0].Failures != 1 {
+		t.Errorf("expected primary unhealthy with 1 failure, got %+v", health[0])
+	}
+	if !health[1].Healthy {
+		t.Errorf("expected fallback healthy, got %+v", health[1])
+	}
+
+	// the unhealthy primary is skipped while it cools down
+	if _, err := client.GetPrices(context.Background(), "SOL"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if primaryCalls.Load() != 1 {
+		t.Errorf("expected primary to be called once, got %d", primaryCalls.Load())
+	}
+}
+
+func TestFailover_SwitchesOnConnectionError(t *testing.T) {
+	down := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {})
+	down.Close()
+	fallback := newTestServer(t, jsonHandler(t, http.MethodGet, "/price/v3", PriceV3Response{}))
+
+	client := newTestClient("https://unused.invalid")
+	WithFailover([]BaseURL{{URL: down.URL}, {URL: fallback.URL}}, nil)(client)
+
+	ctx, info := ContextWithCallInfo(context.Background())
+	if _, err := client.GetPrices(ctx, "SOL"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.BaseURL() != fallback.URL {
+		t.Errorf("expected base URL %s, got %s", fallback.URL, info.BaseURL())
+	}
+}
+
+func TestFailover_ConnectionErrorReportsBaseURL(t *testing.T) {
+	down := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {})
+	down.Close()
+
+	client := newTestClient("https://unused.invalid")
+	WithFailover([]BaseURL{{URL: down.URL}}, nil)(client)
+
+	_, err := client.GetPrices(context.Background(), "SOL")
+	var baseErr *BaseURLError
+	if !errors.As(err, &baseErr) {
+		t.Fatalf("expected BaseURLError, got %v", err)
+	}
+	if baseErr.BaseURL != down.URL {
+		t.Errorf("expected base URL %s, got %s", down.URL, baseErr.BaseURL)
+	}
+	var tErr *transportError
+	if !errors.As(err, &tErr) {
+		t.Errorf("expected the transport error to stay reachable, got %v", err)
+	}
+}
+
+func TestFailover_ChargesEachBaseURL(t *testing.T) {
+	primary := newTestServer(t, errorHandler(http.StatusBadGateway, "down"))
+	var fallbackKey string
+	fallback := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		fallbackKey = r.Header.Get(ApiKeyHeader)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	})
+
+	client := newTestClient("https://unused.invalid")
+	WithFailover([]BaseURL{{URL: primary.URL}, {URL: fallback.URL}}, nil)(client)
+	client.Limiter = rate.NewLimiter(rate.Every(time.Hour), 2)
+	client.KeyPool = NewKeyPool([]string{"key-a", "key-b"}, RateBucket{})
+
+	if _, err := client.GetPrices(context.Background(), "SOL"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tokens := client.Limiter.Tokens(); tokens >= 1 {
+		t.Errorf("expected both base URLs to take a limiter token, %.2f left", tokens)
+	}
+	if fallbackKey != "key-b" {
+		t.Errorf("expected the fallback request to use the next pool key, got %q", fallbackKey)
+	}
+	for _, stats := range client.KeyPool.Stats() {
+		if stats.Requests != 1 {
+			t.Errorf("expected one request reported per key, got %+v", stats)
+		}
+	}
+}
+
+func TestFailover_PerFamilyAndClientErrors(t *testing.T) {
+	var quoteCalls, fallbackCalls atomic.Int32
+	quoteServer := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		quoteCalls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"error":"bad mint"}`))
+	})
+	fallback := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		fallbackCalls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	})
+
+	client := newTestClient("https://unused.invalid")
+	WithFailover([]BaseURL{{URL: fallback.URL}}, map[EndpointFamily][]BaseURL{
+		FamilySwap: {{URL: quoteServer.URL}, {URL: fallback.URL}},
+	})(client)
+
+	_, err := client.GetSwapQuote(context.Background(), SwapQuoteParams{InputMint: WrappedSOL, OutputMint: USDC, Amount: NewAmount(1)})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	if apiErr.BaseURL != quoteServer.URL {
+		t.Errorf("expected APIError.BaseURL %s, got %s", quoteServer.URL, apiErr.BaseURL)
+	}
+	if fallbackCalls.Load() != 0 {
+		t.Errorf("expected 4xx not to fail over, got %d fallback calls", fallbackCalls.Load())
+	}
+
+	if _, err := client.GetPrices(context.Background(), "SOL"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if quoteCalls.Load() != 1 || fallbackCalls.Load() != 1 {
+		t.Errorf("expected price to use the default list, got quote=%d fallback=%d", quoteCalls.Load(), fallbackCalls.Load())
+	}
+}
+
+func TestFailover_PostNotRepeatedAfter5xx(t *testing.T) {
+	var fallbackCalls atomic.Int32
+	primary := newTestServer(t, errorHandler(http.StatusInternalServerError, "boom"))
+	fallback := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		fallbackCalls.Add(1)
+	})
+
+	client := newTestClient("https://unused.invalid")
+	WithFailover([]BaseURL{{URL: primary.URL}, {URL: fallback.URL}}, nil)(client)
+
+	_, err := client.ExecuteUltra(context.Background(), ExecuteRequest{})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("expected 500 APIError, got %v", err)
+	}
+	if fallbackCalls.Load() != 0 {
+		t.Errorf("expected POST not to fail over after the server saw it, got %d calls", fallbackCalls.Load())
+	}
+}
+
+func TestFailover_SelfHostedSwapAPI(t *testing.T) {
+	var paths []string
+	var selfHostedKey string
+	selfHosted := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		if r.URL.Path != "/quote" {
+			http.NotFound(w, r)
+			return
+		}
+		selfHostedKey = r.Header.Get(ApiKeyHeader)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	})
+	hosted := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to the hosted API: %s", r.URL.Path)
+	})
+
+	client := newTestClient("https://unused.invalid")
+	WithFailover([]BaseURL{{URL: hosted.URL}}, map[EndpointFamily][]BaseURL{
+		FamilySwap: {SelfHostedSwapAPI(selfHosted.URL), {URL: hosted.URL}},
+	})(client)
+	client.Limiter = rate.NewLimiter(rate.Every(time.Hour), 1)
+
+	ctx, info := ContextWithCallInfo(context.Background())
+	_, err := client.GetSwapQuote(ctx, SwapQuoteParams{InputMint: WrappedSOL, OutputMint: USDC, Amount: NewAmount(1)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.BaseURL() != selfHosted.URL {
+		t.Errorf("expected base URL %s, got %s", selfHosted.URL, info.BaseURL())
+	}
+	if len(paths) != 1 || paths[0] != "/quote" {
+		t.Errorf("expected a single request to /quote, got %v", paths)
+	}
+	if selfHostedKey != "" {
+		t.Errorf("expected no API key on the self-hosted server, got %q", selfHostedKey)
+	}
+	if tokens := client.Limiter.Tokens(); tokens < 1 {
+		t.Errorf("expected the self-hosted call not to take a limiter token, %.2f left", tokens)
+	}
+}
+
+func TestBaseURL_Path(t *testing.T) {
+	selfHosted := SelfHostedSwapAPI("http://localhost:8080")
+	tests := []struct {
+		endpoint string
+		want     string
+	}{
+		{"/swap/v1/quote", "/quote"},
+		{"/swap/v1/swap", "/swap"},
+		{"/swap/v1/swap-instructions", "/swap-instructions"},
+		{"/swap/v10/quote", "/swap/v10/quote"},
+		{"/price/v3", "/price/v3"},
+	}
+	for _, tt := range tests {
+		if got := selfHosted.path(tt.endpoint); got != tt.want {
+			t.Errorf("path(%q) = %q, want %q", tt.endpoint, got, tt.want)
+		}
+	}
+	nested := BaseURL{Prefixes: map[string]string{"/swap": "/a", "/swap/v1": "/b"}}
+	if got := nested.path("/swap/v1/quote"); got != "/b/quote" {
+		t.Errorf("expected the longest prefix to win, got %q", got)
+	}
+}
+
+func TestCallInfo_WithoutFailover(t *testing.T) {
+	server := newTestServer(t, jsonHandler(t, http.MethodGet, "/price/v3", PriceV3Response{}))
+	client := newTestClient(server.URL)
+
+	ctx, info := ContextWithCallInfo(context.Background())
+	if _, err := client.GetPrices(ctx, "SOL"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.BaseURL() != server.URL {
+		t.Errorf("expected base URL %s, got %s", server.URL, info.BaseURL())
+	}
+}
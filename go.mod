@@ -2,4 +2,7 @@ module github.com/serezhaolshan/jupiter-go
 
 go 1.24.4
 
-require golang.org/x/time v0.11.0
+require (
+	github.com/andybalholm/brotli v1.2.0
+	golang.org/x/time v0.11.0
+)
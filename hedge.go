@@ -0,0 +1,203 @@
+package jupiter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"sync"
+	"time"
+)
+
+// HedgePolicy enables request hedging on latency-sensitive endpoints
+// (GetSwapQuote and GetUltraOrder). When the first request has not returned
+// after the hedge delay, a second identical request is sent and whichever
+// succeeds first wins; the other one is cancelled. Both requests go through
+// the rate limiter, so hedging never exceeds the configured quota. The
+// delay and the observed latencies start when the first request is actually
+// sent, so time spent waiting on the rate limiter never triggers a hedge.
+//
+// The hedge delay is Delay, or, when UseP95 is set and at least MinSamples
+// latencies were observed for the endpoint, the 95th percentile of the last
+// WindowSize of them.
+type HedgePolicy struct {
+	Delay      time.Duration
+	UseP95     bool
+	MinSamples int
+	WindowSize int
+
+	mu        sync.Mutex
+	latencies map[string][]time.Duration
+}
+
+func DefaultHedgePolicy() *HedgePolicy {
+	return &HedgePolicy{
+		Delay:      300 * time.Millisecond,
+		UseP95:     true,
+		MinSamples: 20,
+		WindowSize: 100,
+	}
+}
+
+func (p *HedgePolicy) observe(endpoint string, latency time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.latencies == nil {
+		p.latencies = map[string][]time.Duration{}
+	}
+	window := p.WindowSize
+	if window <= 0 {
+		window = 100
+	}
+	samples := append(p.latencies[endpoint], latency)
+	if len(samples) > window {
+		samples = samples[len(samples)-window:]
+	}
+	p.latencies[endpoint] = samples
+}
+
+// delay returns how long to wait for the first request before hedging.
+func (p *HedgePolicy) delay(endpoint string) time.Duration {
+	if !p.UseP95 {
+		return p.Delay
+	}
+	p.mu.Lock()
+	samples := slices.Clone(p.latencies[endpoint])
+	p.mu.Unlock()
+
+	if len(samples) == 0 || len(samples) < p.MinSamples {
+		return p.Delay
+	}
+	slices.Sort(samples)
+	return samples[(len(samples)*95+99)/100-1]
+}
+
+type hedgeResult struct {
+	target       any
+	httpResponse *http.Response
+	err          error
+	info         *CallInfo
+	latency      time.Duration
+}
+
+// hedgeLeg tracks when the request of one hedged leg goes out on the wire.
+// sent is closed on the first send; sentAt is the time of the latest one.
+type hedgeLeg struct {
+	mu     sync.Mutex
+	sent   chan struct{}
+	sentAt time.Time
+}
+
+type hedgeLegKey struct{}
+
+func withHedgeLeg(ctx context.Context) (context.Context, *hedgeLeg) {
+	leg := &hedgeLeg{sent: make(chan struct{})}
+	return context.WithValue(ctx, hedgeLegKey{}, leg), leg
+}
+
+func hedgeLegFrom(ctx context.Context) *hedgeLeg {
+	leg, _ := ctx.Value(hedgeLegKey{}).(*hedgeLeg)
+	return leg
+}
+
+// markSent records that a request is about to go out on the wire, after
+// any rate limit or key pool wait.
+func (l *hedgeLeg) markSent() {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.sentAt.IsZero() {
+		close(l.sent)
+	}
+	l.sentAt = time.Now()
+}
+
+// sinceSent returns the time since the latest request went out.
+func (l *hedgeLeg) sinceSent() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return time.Since(l.sentAt)
+}
+
+// doHedgedCall behaves like doCall but hedges the request when c.Hedge is
+// set.
+func (c *Client) doHedgedCall(ctx context.Context, req *Request, response any) (*http.Response, error) {
+	policy := c.Hedge
+	if policy == nil {
+		return c.doCall(ctx, req, response)
+	}
+	if reflect.TypeOf(response).Kind() != reflect.Pointer {
+		return nil, fmt.Errorf("response struct is not a pointer")
+	}
+
+	// set before the legs start so doCall only reads it
+	req.client = c
+
+	endpoint := c.endpointName(req)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan hedgeResult, 2)
+	launch := func() *hedgeLeg {
+		legCtx, info := ContextWithCallInfo(ctx)
+		legCtx, leg := withHedgeLeg(legCtx)
+		target := reflect.New(reflect.TypeOf(response).Elem()).Interface()
+		go func() {
+			httpResponse, err := c.doCall(legCtx, req, target)
+			results <- hedgeResult{target, httpResponse, err, info, leg.sinceSent()}
+		}()
+		return leg
+	}
+
+	sent := launch().sent
+	inFlight := 1
+	var (
+		timer   *time.Timer
+		timeout <-chan time.Time
+	)
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+
+	var first *hedgeResult
+	for {
+		select {
+		case <-sent:
+			sent = nil
+			timer = time.NewTimer(policy.delay(endpoint))
+			timeout = timer.C
+		case <-timeout:
+			if inFlight == 1 && first == nil {
+				launch()
+				inFlight++
+			}
+		case result := <-results:
+			inFlight--
+			if result.err == nil {
+				policy.observe(endpoint, result.latency)
+				reflect.ValueOf(response).Elem().Set(reflect.ValueOf(result.target).Elem())
+				callInfoFrom(ctx).setBaseURL(result.info.BaseURL())
+				return result.httpResponse, nil
+			}
+			if first == nil {
+				first = &result
+			}
+			if inFlight == 0 {
+				callInfoFrom(ctx).setBaseURL(first.info.BaseURL())
+				return first.httpResponse, first.err
+			}
+		}
+	}
+}
+
+// WithHedging enables request hedging; see HedgePolicy.
+func WithHedging(policy *HedgePolicy) Option {
+	return func(c *Client) {
+		c.Hedge = policy
+	}
+}
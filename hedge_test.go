@@ -0,0 +1,139 @@
+package jupiter
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestHedge_SecondRequestWins(t *testing.T) {
+	var (
+		calls     atomic.Int32
+		cancelled = make(chan struct{}, 1)
+	)
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			select {
+			case <-r.Context().Done():
+				cancelled <- struct{}{}
+			case <-time.After(2 * time.Second):
+			}
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"inputMint":"So11111111111111111111111111111111111111112","outAmount":"42"}`))
+	})
+	client := newTestClient(server.URL)
+	WithHedging(&HedgePolicy{Delay: 20 * time.Millisecond})(client)
+
+	start := time.Now()
+	result, err := client.GetSwapQuote(context.Background(), SwapQuoteParams{InputMint: WrappedSOL, OutputMint: USDC, Amount: NewAmount(1)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.OutAmount != NewAmount(42) {
+		t.Errorf("expected outAmount 42, got %s", result.OutAmount)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected hedge to return early, took %v", elapsed)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("expected 2 requests, got %d", calls.Load())
+	}
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Error("expected the slow request to be cancelled")
+	}
+}
+
+func TestHedge_FastResponseIsNotHedged(t *testing.T) {
+	var calls atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"requestId":"req-1"}`))
+	})
+	client := newTestClient(server.URL)
+	WithHedging(&HedgePolicy{Delay: time.Second})(client)
+
+	result, err := client.GetUltraOrder(context.Background(), UltraOrderParams{InputMint: WrappedSOL, OutputMint: USDC, Amount: NewAmount(1)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.RequestID != "req-1" {
+		t.Errorf("expected requestId req-1, got %s", result.RequestID)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 request, got %d", calls.Load())
+	}
+}
+
+func TestHedge_ChargesRateLimiter(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	})
+	client := newTestClient(server.URL)
+	client.Limiter = rate.NewLimiter(rate.Every(time.Hour), 2)
+	WithHedging(&HedgePolicy{Delay: 5 * time.Millisecond})(client)
+
+	if _, err := client.GetSwapQuote(context.Background(), SwapQuoteParams{InputMint: WrappedSOL, OutputMint: USDC, Amount: NewAmount(1)}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tokens := client.Limiter.Tokens(); tokens >= 0.5 {
+		t.Errorf("expected both requests to consume a token, %.2f left", tokens)
+	}
+}
+
+func TestHedge_LimiterWaitDoesNotHedge(t *testing.T) {
+	var calls atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	})
+	client := newTestClient(server.URL)
+	client.Limiter = rate.NewLimiter(rate.Every(100*time.Millisecond), 1)
+	client.Limiter.Allow()
+	policy := &HedgePolicy{Delay: 20 * time.Millisecond}
+	WithHedging(policy)(client)
+
+	if _, err := client.GetSwapQuote(context.Background(), SwapQuoteParams{InputMint: WrappedSOL, OutputMint: USDC, Amount: NewAmount(1)}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 request, got %d", calls.Load())
+	}
+	if tokens := client.Limiter.Tokens(); tokens < -0.5 {
+		t.Errorf("expected no hedge to reserve a token while waiting, %.2f left", tokens)
+	}
+	latencies := policy.latencies["/swap/v1/quote"]
+	if len(latencies) != 1 || latencies[0] >= 80*time.Millisecond {
+		t.Errorf("expected latency to exclude the limiter wait, got %v", latencies)
+	}
+}
+
+func TestHedgePolicy_DelayUsesP95(t *testing.T) {
+	policy := &HedgePolicy{Delay: time.Second, UseP95: true, MinSamples: 10}
+	for i := 1; i <= 9; i++ {
+		policy.observe("/swap/v1/quote", time.Duration(i)*time.Millisecond)
+	}
+	if got := policy.delay("/swap/v1/quote"); got != time.Second {
+		t.Errorf("expected Delay before MinSamples, got %v", got)
+	}
+	for i := 10; i <= 100; i++ {
+		policy.observe("/swap/v1/quote", time.Duration(i)*time.Millisecond)
+	}
+	if got := policy.delay("/swap/v1/quote"); got != 95*time.Millisecond {
+		t.Errorf("expected p95 of 95ms, got %v", got)
+	}
+	if got := policy.delay("/ultra/v1/order"); got != time.Second {
+		t.Errorf("expected Delay for an endpoint without samples, got %v", got)
+	}
+}
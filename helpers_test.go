@@ -45,3 +45,36 @@ func errorHandler(statusCode int, body string) http.HandlerFunc {
 		w.Write([]byte(body))
 	}
 }
+
+// testPubkey returns a distinct, valid public key for fixtures.
+func testPubkey(seed byte) Pubkey {
+	var key [PubkeyLength]byte
+	key[0], key[31] = seed, seed
+	return PubkeyFromBytes(key)
+}
+
+var (
+	testUser       = testPubkey(1)
+	testPayer      = testPubkey(2)
+	testOrder      = testPubkey(3)
+	testOrder2     = testPubkey(4)
+	testAMM        = testPubkey(5)
+	testFeeAccount = testPubkey(6)
+	testSender     = testPubkey(7)
+	testInvite     = testPubkey(8)
+	testReferral   = testPubkey(9)
+	testDest       = testPubkey(10)
+	testMint       = testPubkey(11)
+	testInvite2    = testPubkey(12)
+	testAccount    = testPubkey(13)
+	testLookup     = testPubkey(14)
+)
+
+// testDecimal parses a decimal fixture, panicking on invalid input.
+func testDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
@@ -4,11 +4,15 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptrace"
 	"net/url"
 	"reflect"
+	"slices"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/time/rate"
@@ -21,19 +25,48 @@ const ApiKeyHeader = "x-api-key"
 const RateLimitMilliseconds = 120
 
 type Client struct {
-	ApiUrl  string
-	ApiKey  string
+	ApiUrl string
+	ApiKey string
+	// KeyPool, when set, supplies the API key instead of ApiKey.
+	KeyPool *KeyPool
 	Limiter *rate.Limiter
-	c       *http.Client
+	// RateLimiter, when set, is used instead of Limiter.
+	RateLimiter *AdaptiveLimiter
+	// Retry is consulted by every call; nil means a single attempt.
+	Retry *RetryPolicy
+	// Breakers, when set, fail calls fast while their family is unhealthy.
+	Breakers *CircuitBreakers
+	// Failover, when set, chooses the base URL of every call instead of ApiUrl.
+	Failover *Failover
+	// Hedge, when set, hedges GetSwapQuote and GetUltraOrder requests.
+	Hedge *HedgePolicy
+	// Redactor strips secrets from the URLs in errors; nil disables it.
+	Redactor *Redactor
+
+	middlewares     []Middleware
+	c               *http.Client
+	timeout         time.Duration
+	userAgent       string
+	headers         http.Header
+	maxResponseSize int64
+	acceptEncoding  string
 }
 
-func NewClient(url, key string) *Client {
-	return &Client{
+func NewClient(url, key string, opts ...Option) *Client {
+	client := &Client{
 		ApiUrl:  url,
 		ApiKey:  key,
 		Limiter: rate.NewLimiter(rate.Every(RateLimitMilliseconds*time.Millisecond), 1),
 		c:       http.DefaultClient,
+		headers: http.Header{},
+
+		Redactor:        DefaultRedactor(),
+		maxResponseSize: DefaultMaxResponseSize,
+	}
+	for _, opt := range opts {
+		opt(client)
 	}
+	return client
 }
 
 func (c *Client) Url(endpoint string) string {
@@ -41,58 +74,146 @@ func (c *Client) Url(endpoint string) string {
 }
 
 func (c *Client) doCall(ctx context.Context, req *Request, response any) (*http.Response, error) {
-	err := c.Limiter.Wait(ctx)
-	if err != nil {
-		return nil, err
-	}
 	if reflect.TypeOf(response).Kind() != reflect.Pointer {
 		return nil, fmt.Errorf("response struct is not a pointer")
 	}
-	httpRequest, err := req.NewHttpRequest(ctx, c.ApiKey)
+	if req.client != c {
+		req.client = c
+	}
+
+	attempts := c.Retry.attempts()
+	for attempt := 1; ; attempt++ {
+		httpResponse, err := c.doAttempt(ctx, req, response)
+		if err == nil {
+			return httpResponse, nil
+		}
+		if attempt >= attempts || ctx.Err() != nil || !c.Retry.shouldRetry(req, err) {
+			return nil, err
+		}
+		wait, ok := c.Retry.backoff(attempt, httpResponse)
+		if !ok {
+			return nil, err
+		}
+		if sleepErr := sleep(ctx, wait); sleepErr != nil {
+			return nil, err
+		}
+	}
+}
+
+// doAttempt runs a single attempt through the middleware chain. On API
+// errors the http.Response is returned alongside the error so the retry
+// policy can inspect its headers.
+func (c *Client) doAttempt(ctx context.Context, req *Request, response any) (*http.Response, error) {
+	httpRequest, err := req.NewHttpRequest(ctx, "")
 	if err != nil {
-		return nil, fmt.Errorf("api call %v() on %v: %v", req.Method, req.Endpoint, err.Error())
+		return nil, fmt.Errorf("api call %v() on %v: %v", req.Method, req.Endpoint, c.Redactor.redactError(err).Error())
+	}
+	for key, values := range c.headers {
+		httpRequest.Header[key] = slices.Clone(values)
+	}
+	if c.userAgent != "" {
+		httpRequest.Header.Set("User-Agent", c.userAgent)
+	}
+
+	call := &Call{
+		Endpoint:   c.endpointName(req),
+		Request:    httpRequest,
+		Target:     response,
+		Idempotent: req.Idempotent,
+	}
+	callInfoFrom(ctx).setBaseURL(c.ApiUrl)
+	return c.handler()(call)
+}
+
+// send is the innermost handler of the middleware chain: it performs the
+// HTTP round trip and decodes the body into call.Target.
+func (c *Client) send(call *Call) (*http.Response, error) {
+	httpRequest := call.Request
+	ctx := httpRequest.Context()
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	var written atomic.Bool
+	trace := &httptrace.ClientTrace{
+		WroteHeaderField: func(string, []string) { written.Store(true) },
+	}
+	httpRequest = httpRequest.WithContext(httptrace.WithClientTrace(ctx, trace))
+	if c.acceptEncoding != "" {
+		httpRequest.Header.Set("Accept-Encoding", c.acceptEncoding)
 	}
+
+	requestURL := c.Redactor.URL(httpRequest.URL.String())
+
+	hedgeLegFrom(ctx).markSent()
 	httpResponse, err := c.c.Do(httpRequest)
 	if err != nil {
-		return nil, fmt.Errorf("api call %v() on %v: %v", req.Method, httpRequest.URL.String(), err.Error())
+		err = c.Redactor.redactError(err)
+		return nil, &transportError{
+			msg:     fmt.Sprintf("api call %v() on %v: %v", httpRequest.Method, requestURL, err.Error()),
+			err:     err,
+			written: written.Load(),
+		}
 	}
 
-	bodyBytes, err := io.ReadAll(httpResponse.Body)
+	defer httpResponse.Body.Close()
+
+	body, err := c.responseBody(httpResponse)
 	if err != nil {
 		return nil, fmt.Errorf(
 			"call %v() on %v status code: %v. could not decode body to response: %v",
-			req.Method,
-			httpRequest.URL.String(),
+			httpRequest.Method,
+			requestURL,
 			httpResponse.StatusCode,
 			err.Error())
 	}
-	defer httpResponse.Body.Close()
 
 	if httpResponse.StatusCode >= http.StatusBadRequest {
-		return nil, &APIError{
+		bodyBytes, err := io.ReadAll(body)
+		if err != nil && !errors.Is(err, ErrResponseTooLarge) {
+			return nil, fmt.Errorf(
+				"call %v() on %v status code: %v. could not decode body to response: %v",
+				httpRequest.Method,
+				requestURL,
+				httpResponse.StatusCode,
+				err.Error())
+		}
+		apiErr := &APIError{
 			StatusCode: httpResponse.StatusCode,
 			RawBody:    bodyBytes,
-			Method:     req.Method,
-			URL:        httpRequest.URL.String(),
+			Method:     httpRequest.Method,
+			URL:        requestURL,
 		}
+		apiErr.decodeBody()
+		return httpResponse, apiErr
 	}
 
-	err = json.Unmarshal(bodyBytes, response)
-
+	err = json.NewDecoder(body).Decode(call.Target)
+	if errors.Is(err, ErrResponseTooLarge) {
+		return nil, &ResponseTooLargeError{
+			Limit:  c.maxResponseSize,
+			Method: httpRequest.Method,
+			URL:    requestURL,
+		}
+	}
 	if err != nil {
 		return nil, fmt.Errorf(
 			"call %v() on %v status code: %v. could not decode body to response model: %v",
-			req.Method,
-			httpRequest.URL.String(),
+			httpRequest.Method,
+			requestURL,
 			httpResponse.StatusCode,
 			err.Error())
 	}
-	if response == nil {
+	if call.Target == nil {
 		return nil, fmt.Errorf("call %v() on %v status code: %v. response missing",
-			req.Method,
-			httpRequest.URL.String(),
+			httpRequest.Method,
+			requestURL,
 			httpResponse.StatusCode)
 	}
+	// drain what is left so the connection can be reused
+	io.Copy(io.Discard, io.LimitReader(body, 4<<10))
 
 	return httpResponse, nil
 }
@@ -101,7 +222,15 @@ type Request struct {
 	Endpoint    string
 	Method      string
 	QueryParams url.Values
-	Body        io.Reader
+	// Body holds the marshalled JSON so it can be sent again on retries
+	// and redirects.
+	Body []byte
+	// Idempotent requests may be retried after the server has seen them.
+	Idempotent bool
+
+	// client is the client that sent the request; its Redactor is used by
+	// String and GoString.
+	client *Client
 }
 
 func NewRequest(endpoint string, queryParams url.Values, methods ...string) *Request {
@@ -113,6 +242,7 @@ func NewRequest(endpoint string, queryParams url.Values, methods ...string) *Req
 		Endpoint:    endpoint,
 		Method:      method,
 		QueryParams: queryParams,
+		Idempotent:  method == http.MethodGet || method == http.MethodHead,
 	}
 }
 
@@ -124,7 +254,7 @@ func NewPostRequest(endpoint string, body any) (*Request, error) {
 	return &Request{
 		Endpoint: endpoint,
 		Method:   http.MethodPost,
-		Body:     bytes.NewReader(jsonBody),
+		Body:     jsonBody,
 	}, nil
 }
 
@@ -134,10 +264,19 @@ func (r *Request) NewHttpRequest(ctx context.Context, apiKey string) (*http.Requ
 		fullURL = fmt.Sprintf("%s?%s", r.Endpoint, r.QueryParams.Encode())
 	}
 
-	request, err := http.NewRequest(r.Method, fullURL, r.Body)
+	var body io.Reader
+	if r.Body != nil {
+		body = bytes.NewReader(r.Body)
+	}
+	request, err := http.NewRequest(r.Method, fullURL, body)
 	if err != nil {
 		return nil, err
 	}
+	if r.Body != nil {
+		request.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(r.Body)), nil
+		}
+	}
 
 	request.Header.Set("Content-Type", "application/json")
 	if apiKey != "" {
@@ -146,4 +285,4 @@ func (r *Request) NewHttpRequest(ctx context.Context, apiKey string) (*http.Requ
 	request = request.WithContext(ctx)
 
 	return request, nil
-}
\ No newline at end of file
+}
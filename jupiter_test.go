@@ -108,9 +108,8 @@ func TestNewPostRequest(t *testing.T) {
 		t.Fatal("expected body to be set")
 	}
 
-	bodyBytes, _ := io.ReadAll(req.Body)
 	var decoded ExecuteRequest
-	json.Unmarshal(bodyBytes, &decoded)
+	json.Unmarshal(req.Body, &decoded)
 	if decoded.SignedTransaction != "tx123" {
 		t.Errorf("expected SignedTransaction tx123, got %s", decoded.SignedTransaction)
 	}
@@ -189,11 +188,10 @@ func TestNewHttpRequest_WithQueryParams(t *testing.T) {
 }
 
 func TestNewHttpRequest_WithBody(t *testing.T) {
-	body := bytes.NewReader([]byte(`{"key":"value"}`))
 	req := &Request{
 		Endpoint: "https://api.jup.ag/test",
 		Method:   http.MethodPost,
-		Body:     body,
+		Body:     []byte(`{"key":"value"}`),
 	}
 
 	httpReq, err := req.NewHttpRequest(context.Background(), "")
@@ -207,6 +205,63 @@ func TestNewHttpRequest_WithBody(t *testing.T) {
 	}
 }
 
+func TestNewHttpRequest_ReplayableBody(t *testing.T) {
+	req, err := NewPostRequest("https://api.jup.ag/test", map[string]string{"key": "value"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		httpReq, err := req.NewHttpRequest(context.Background(), "")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		bodyBytes, _ := io.ReadAll(httpReq.Body)
+		if string(bodyBytes) != `{"key":"value"}` {
+			t.Errorf("build %d: expected body {\"key\":\"value\"}, got %s", i, string(bodyBytes))
+		}
+		if httpReq.GetBody == nil {
+			t.Fatal("expected GetBody to be set")
+		}
+		replay, _ := httpReq.GetBody()
+		replayBytes, _ := io.ReadAll(replay)
+		if !bytes.Equal(replayBytes, bodyBytes) {
+			t.Errorf("build %d: expected GetBody to return %s, got %s", i, bodyBytes, replayBytes)
+		}
+		if httpReq.ContentLength != int64(len(bodyBytes)) {
+			t.Errorf("build %d: expected content length %d, got %d", i, len(bodyBytes), httpReq.ContentLength)
+		}
+	}
+}
+
+func TestDoCall_PostFollowsTemporaryRedirect(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/old" {
+			http.Redirect(w, r, "/new", http.StatusTemporaryRedirect)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"key":"value"}` {
+			t.Errorf("expected redirected body {\"key\":\"value\"}, got %s", string(body))
+		}
+		jsonHandler(t, http.MethodPost, "/new", map[string]string{"ok": "yes"})(w, r)
+	})
+	client := newTestClient(server.URL)
+
+	req, err := NewPostRequest(client.Url("/old"), map[string]string{"key": "value"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var response map[string]string
+	_, err = client.doCall(context.Background(), req, &response)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if response["ok"] != "yes" {
+		t.Errorf("expected ok=yes, got %v", response)
+	}
+}
+
 func TestDoCall_Success(t *testing.T) {
 	server := newTestServer(t, jsonHandler(t, http.MethodGet, "/test", map[string]string{"hello": "world"}))
 	client := newTestClient(server.URL)
@@ -0,0 +1,205 @@
+package jupiter
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+type KeySelection int
+
+const (
+	// RoundRobin hands out available keys in turn.
+	RoundRobin KeySelection = iota
+	// LeastRecentlyLimited prefers the key whose last 401/403/429 is the
+	// oldest, falling back to round-robin order between equals.
+	LeastRecentlyLimited
+)
+
+const DefaultKeyQuarantine = 30 * time.Second
+
+type KeyStats struct {
+	// Key is masked to its last four characters.
+	Key              string
+	Requests         int64
+	RateLimited      int64
+	Unauthorized     int64
+	Errors           int64
+	LastLimited      time.Time
+	QuarantinedUntil time.Time
+}
+
+type pooledKey struct {
+	key     string
+	limiter *rate.Limiter
+	stats   KeyStats
+}
+
+// KeyPool shares several API keys between the calls of a Client. Each key
+// has its own limiter and is quarantined for Quarantine after the API
+// answers 401, 403 or 429 with it.
+type KeyPool struct {
+	Selection  KeySelection
+	Quarantine time.Duration
+
+	mu   sync.Mutex
+	keys []*pooledKey
+	next int
+}
+
+// NewKeyPool creates a pool of keys, each limited by perKey. A zero perKey
+// leaves the keys unlimited.
+func NewKeyPool(keys []string, perKey RateBucket) *KeyPool {
+	limit := perKey.Limit
+	if limit == 0 {
+		limit = rate.Inf
+	}
+	pool := &KeyPool{
+		Selection:  RoundRobin,
+		Quarantine: DefaultKeyQuarantine,
+	}
+	for _, key := range keys {
+		pool.keys = append(pool.keys, &pooledKey{
+			key:     key,
+			limiter: rate.NewLimiter(limit, max(perKey.Burst, 1)),
+			stats:   KeyStats{Key: maskKey(key)},
+		})
+	}
+	return pool
+}
+
+// Acquire picks a key and waits on its limiter. When every key is
+// quarantined it waits for the first one to be released.
+func (p *KeyPool) Acquire(ctx context.Context) (string, error) {
+	for {
+		p.mu.Lock()
+		if len(p.keys) == 0 {
+			p.mu.Unlock()
+			return "", fmt.Errorf("key pool is empty")
+		}
+		chosen, wait := p.pick(time.Now())
+		p.mu.Unlock()
+
+		if chosen == nil {
+			if err := sleep(ctx, wait); err != nil {
+				return "", err
+			}
+			continue
+		}
+		if err := chosen.limiter.Wait(ctx); err != nil {
+			return "", err
+		}
+		return chosen.key, nil
+	}
+}
+
+// pick returns an available key, or nil and the time until the earliest
+// quarantine ends.
+func (p *KeyPool) pick(now time.Time) (*pooledKey, time.Duration) {
+	var (
+		chosen   *pooledKey
+		position int
+		wait     time.Duration = -1
+	)
+	for i := range p.keys {
+		index := (p.next + i) % len(p.keys)
+		k := p.keys[index]
+		if until := k.stats.QuarantinedUntil; until.After(now) {
+			if d := until.Sub(now); wait < 0 || d < wait {
+				wait = d
+			}
+			continue
+		}
+		if chosen == nil || (p.Selection == LeastRecentlyLimited && k.stats.LastLimited.Before(chosen.stats.LastLimited)) {
+			chosen, position = k, index
+		}
+		if p.Selection == RoundRobin {
+			break
+		}
+	}
+	if chosen != nil {
+		p.next = (position + 1) % len(p.keys)
+	}
+	return chosen, wait
+}
+
+// Report records the outcome of a request made with key. httpResponse is
+// nil when the request failed before a response was received.
+func (p *KeyPool) Report(key string, httpResponse *http.Response) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	for _, k := range p.keys {
+		if k.key != key {
+			continue
+		}
+		k.stats.Requests++
+		if httpResponse == nil {
+			k.stats.Errors++
+			return
+		}
+		switch httpResponse.StatusCode {
+		case http.StatusTooManyRequests:
+			k.stats.RateLimited++
+		case http.StatusUnauthorized, http.StatusForbidden:
+			k.stats.Unauthorized++
+		default:
+			return
+		}
+		now := time.Now()
+		k.stats.LastLimited = now
+		until := now.Add(p.Quarantine)
+		if d, ok := retryAfter(httpResponse.Header.Get("Retry-After"), now); ok && now.Add(d).After(until) {
+			until = now.Add(d)
+		}
+		k.stats.QuarantinedUntil = until
+		return
+	}
+}
+
+func (p *KeyPool) Stats() []KeyStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stats := make([]KeyStats, len(p.keys))
+	for i, k := range p.keys {
+		stats[i] = k.stats
+	}
+	return stats
+}
+
+func maskKey(key string) string {
+	if len(key) <= 4 {
+		return "****"
+	}
+	return "****" + key[len(key)-4:]
+}
+
+// KeyPoolMiddleware sets the x-api-key header from pool and reports the
+// outcome of every call back to it.
+func KeyPoolMiddleware(pool *KeyPool) Middleware {
+	return func(next Handler) Handler {
+		return func(call *Call) (*http.Response, error) {
+			key, err := pool.Acquire(call.Request.Context())
+			if err != nil {
+				return nil, err
+			}
+			call.Request.Header.Set(ApiKeyHeader, key)
+			httpResponse, err := next(call)
+			pool.Report(key, httpResponse)
+			return httpResponse, err
+		}
+	}
+}
+
+// WithKeyPool makes the client take its API key from pool instead of
+// ApiKey.
+func WithKeyPool(pool *KeyPool) Option {
+	return func(c *Client) {
+		c.KeyPool = pool
+	}
+}
@@ -0,0 +1,167 @@
+package jupiter
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestKeyPool_RoundRobin(t *testing.T) {
+	pool := NewKeyPool([]string{"key-a", "key-b", "key-c"}, RateBucket{})
+
+	var got []string
+	for i := 0; i < 6; i++ {
+		key, err := pool.Acquire(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = append(got, key)
+	}
+	want := []string{"key-a", "key-b", "key-c", "key-a", "key-b", "key-c"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+	}
+}
+
+func TestKeyPool_QuarantineSkipsKey(t *testing.T) {
+	pool := NewKeyPool([]string{"key-a", "key-b"}, RateBucket{})
+	pool.Report("key-a", &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}})
+
+	for i := 0; i < 3; i++ {
+		key, err := pool.Acquire(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if key != "key-b" {
+			t.Errorf("expected quarantined key-a to be skipped, got %s", key)
+		}
+	}
+}
+
+func TestKeyPool_AllQuarantinedWaits(t *testing.T) {
+	pool := NewKeyPool([]string{"key-a"}, RateBucket{})
+	pool.Quarantine = 30 * time.Millisecond
+	pool.Report("key-a", &http.Response{StatusCode: http.StatusForbidden, Header: http.Header{}})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	_, err := pool.Acquire(ctx)
+	cancel()
+	if err == nil {
+		t.Fatal("expected acquire to block while every key is quarantined")
+	}
+
+	start := time.Now()
+	key, err := pool.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if key != "key-a" {
+		t.Errorf("expected key-a, got %s", key)
+	}
+	if time.Since(start) > time.Second {
+		t.Errorf("expected key to be released after quarantine, waited %v", time.Since(start))
+	}
+}
+
+func TestKeyPool_LeastRecentlyLimited(t *testing.T) {
+	pool := NewKeyPool([]string{"key-a", "key-b", "key-c"}, RateBucket{})
+	pool.Selection = LeastRecentlyLimited
+	pool.Quarantine = 0
+
+	limited := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	pool.Report("key-a", limited)
+	time.Sleep(time.Millisecond)
+	pool.Report("key-c", limited)
+	time.Sleep(time.Millisecond)
+	pool.Report("key-b", limited)
+
+	key, err := pool.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if key != "key-a" {
+		t.Errorf("expected least recently limited key-a, got %s", key)
+	}
+}
+
+func TestKeyPool_Stats(t *testing.T) {
+	pool := NewKeyPool([]string{"secret-key-a", "abc"}, RateBucket{})
+	pool.Report("secret-key-a", &http.Response{StatusCode: http.StatusOK, Header: http.Header{}})
+	pool.Report("secret-key-a", &http.Response{StatusCode: http.StatusUnauthorized, Header: http.Header{}})
+	pool.Report("secret-key-a", nil)
+	pool.Report("abc", &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"120"}}})
+
+	stats := pool.Stats()
+	if len(stats) != 2 {
+		t.Fatalf("expected 2 stats entries, got %d", len(stats))
+	}
+	if stats[0].Key != "****ey-a" {
+		t.Errorf("expected masked key ****ey-a, got %s", stats[0].Key)
+	}
+	if stats[0].Requests != 3 || stats[0].Unauthorized != 1 || stats[0].Errors != 1 {
+		t.Errorf("unexpected stats for first key: %+v", stats[0])
+	}
+	if stats[1].Key != "****" {
+		t.Errorf("expected short key fully masked, got %s", stats[1].Key)
+	}
+	if stats[1].RateLimited != 1 {
+		t.Errorf("expected 1 rate limited request, got %d", stats[1].RateLimited)
+	}
+	if time.Until(stats[1].QuarantinedUntil) < time.Minute {
+		t.Errorf("expected Retry-After to extend quarantine, got %v", stats[1].QuarantinedUntil)
+	}
+}
+
+func TestKeyPool_Empty(t *testing.T) {
+	pool := NewKeyPool(nil, RateBucket{})
+	if _, err := pool.Acquire(context.Background()); err == nil {
+		t.Fatal("expected error for empty pool")
+	}
+}
+
+func TestClient_WithKeyPool(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		seen []string
+	)
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(ApiKeyHeader)
+		mu.Lock()
+		seen = append(seen, key)
+		mu.Unlock()
+		if key == "revoked-key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	})
+	pool := NewKeyPool([]string{"revoked-key", "good-key"}, RateBucket{})
+	client := newTestClient(server.URL)
+	client.ApiKey = "ignored"
+	WithKeyPool(pool)(client)
+	client.Retry = &RetryPolicy{MaxAttempts: 2, RetryableStatusCodes: []int{http.StatusUnauthorized}}
+
+	_, err := client.GetRouters(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, err = client.GetRouters(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"revoked-key", "good-key", "good-key"}
+	if len(seen) != len(want) {
+		t.Fatalf("expected keys %v, got %v", want, seen)
+	}
+	for i := range want {
+		if seen[i] != want[i] {
+			t.Fatalf("expected keys %v, got %v", want, seen)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package jupiter
+
+import (
+	"context"
+	"net/url"
+)
+
+type GetEarnEarningsParams struct {
+	User      Pubkey
+	Positions []Pubkey
+}
+
+// EarnEarnings reports raw amounts of one position. Earnings is the balance
+// plus withdrawals minus deposits and can be negative, so it is a Decimal.
+type EarnEarnings struct {
+	Address        Pubkey  `json:"address"`
+	OwnerAddress   Pubkey  `json:"ownerAddress"`
+	TotalDeposits  Amount  `json:"totalDeposits"`
+	TotalWithdraws Amount  `json:"totalWithdraws"`
+	TotalBalance   Amount  `json:"totalBalance"`
+	TotalAssets    Amount  `json:"totalAssets"`
+	Earnings       Decimal `json:"earnings"`
+	Slot           int64   `json:"slot"`
+}
+
+func (c *Client) GetEarnEarnings(ctx context.Context, params GetEarnEarningsParams) ([]EarnEarnings, error) {
+	if err := requirePubkey("user", params.User); err != nil {
+		return nil, err
+	}
+	if err := requirePubkeys("positions", params.Positions); err != nil {
+		return nil, err
+	}
+	queryParams := url.Values{}
+	queryParams.Set("user", params.User.String())
+
+	if len(params.Positions) > 0 {
+		queryParams.Set("positions", joinPubkeys(params.Positions))
+	}
+
+	request := NewRequest(c.Url("/lend/v1/earn/earnings"), queryParams)
+	var response []EarnEarnings
+	_, err := c.doCall(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
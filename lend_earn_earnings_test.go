@@ -0,0 +1,74 @@
+package jupiter
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetEarnEarnings(t *testing.T) {
+	earnings := []EarnEarnings{
+		{
+			Address:       testOrder,
+			OwnerAddress:  testUser,
+			TotalDeposits: NewAmount(1000000),
+			TotalBalance:  NewAmount(1012000),
+			Earnings:      testDecimal("12000"),
+			Slot:          350000000,
+		},
+	}
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		jsonHandler(t, http.MethodGet, "/lend/v1/earn/earnings", earnings)(w, r)
+		q := r.URL.Query()
+		if q.Get("user") != testUser.String() {
+			t.Errorf("expected user=%s, got %s", testUser, q.Get("user"))
+		}
+		if want := testOrder.String() + "," + testOrder2.String(); q.Get("positions") != want {
+			t.Errorf("expected positions=%s, got %s", want, q.Get("positions"))
+		}
+	})
+	client := newTestClient(server.URL)
+
+	result, err := client.GetEarnEarnings(context.Background(), GetEarnEarningsParams{
+		User:      testUser,
+		Positions: []Pubkey{testOrder, testOrder2},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 1 {
+		t.Fatalf("expected 1 earnings entry, got %d", len(result))
+	}
+	if result[0].Earnings.String() != "12000" {
+		t.Errorf("expected earnings 12000, got %s", result[0].Earnings)
+	}
+	if result[0].Slot != 350000000 {
+		t.Errorf("expected slot 350000000, got %d", result[0].Slot)
+	}
+}
+
+func TestGetEarnEarnings_NoPositions(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		jsonHandler(t, http.MethodGet, "/lend/v1/earn/earnings", []EarnEarnings{})(w, r)
+		if r.URL.Query().Has("positions") {
+			t.Errorf("expected no positions param, got %s", r.URL.Query().Get("positions"))
+		}
+	})
+	client := newTestClient(server.URL)
+
+	_, err := client.GetEarnEarnings(context.Background(), GetEarnEarningsParams{User: testUser})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestGetEarnEarnings_Error(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusBadRequest, "missing user"))
+	client := newTestClient(server.URL)
+
+	_, err := client.GetEarnEarnings(context.Background(), GetEarnEarningsParams{User: testUser})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,34 @@
+package jupiter
+
+import (
+	"context"
+	"net/url"
+)
+
+type EarnPosition struct {
+	Token             EarnToken `json:"token"`
+	OwnerAddress      Pubkey    `json:"ownerAddress"`
+	Shares            Amount    `json:"shares"`
+	UnderlyingAssets  Amount    `json:"underlyingAssets"`
+	UnderlyingBalance Amount    `json:"underlyingBalance"`
+	Allowance         Amount    `json:"allowance"`
+}
+
+func (c *Client) GetEarnPositions(ctx context.Context, users []Pubkey) ([]EarnPosition, error) {
+	if len(users) == 0 {
+		return nil, &FieldError{Field: "users", Err: ErrMissingPubkey}
+	}
+	if err := requirePubkeys("users", users); err != nil {
+		return nil, err
+	}
+	queryParams := url.Values{}
+	queryParams.Set("users", joinPubkeys(users))
+
+	request := NewRequest(c.Url("/lend/v1/earn/positions"), queryParams)
+	var response []EarnPosition
+	_, err := c.doCall(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
@@ -0,0 +1,71 @@
+package jupiter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestGetEarnPositions(t *testing.T) {
+	positions := []EarnPosition{
+		{
+			Token:             EarnToken{Symbol: "jlUSDC"},
+			OwnerAddress:      testUser,
+			Shares:            NewAmount(990000),
+			UnderlyingAssets:  NewAmount(1000000),
+			UnderlyingBalance: NewAmount(25000000),
+		},
+		{
+			Token:        EarnToken{Symbol: "jlSOL"},
+			OwnerAddress: testPayer,
+			Shares:       NewAmount(5),
+		},
+	}
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		jsonHandler(t, http.MethodGet, "/lend/v1/earn/positions", positions)(w, r)
+		if want := testUser.String() + "," + testPayer.String(); r.URL.Query().Get("users") != want {
+			t.Errorf("expected users=%s, got %s", want, r.URL.Query().Get("users"))
+		}
+	})
+	client := newTestClient(server.URL)
+
+	result, err := client.GetEarnPositions(context.Background(), []Pubkey{testUser, testPayer})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 2 {
+		t.Fatalf("expected 2 positions, got %d", len(result))
+	}
+	if result[0].UnderlyingAssets != NewAmount(1000000) {
+		t.Errorf("expected underlyingAssets 1000000, got %s", result[0].UnderlyingAssets)
+	}
+	if result[1].Token.Symbol != "jlSOL" {
+		t.Errorf("expected token jlSOL, got %s", result[1].Token.Symbol)
+	}
+}
+
+func TestGetEarnPositions_Error(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusBadRequest, "missing users"))
+	client := newTestClient(server.URL)
+
+	_, err := client.GetEarnPositions(context.Background(), nil)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestEarnPosition_Decode(t *testing.T) {
+	var position EarnPosition
+	err := json.Unmarshal([]byte(`{"token":{"id":2,"symbol":"jlUSDT","asset":{"symbol":"USDT"}},"ownerAddress":"`+testUser.String()+`","allowance":"0"}`), &position)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if position.Token.ID != 2 {
+		t.Errorf("expected token id 2, got %d", position.Token.ID)
+	}
+	if position.Token.Asset.Symbol != "USDT" {
+		t.Errorf("expected asset USDT, got %s", position.Token.Asset.Symbol)
+	}
+}
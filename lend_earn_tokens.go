@@ -0,0 +1,58 @@
+package jupiter
+
+import (
+	"context"
+	"net/url"
+)
+
+type LendAsset struct {
+	Address     Pubkey `json:"address"`
+	ChainID     string `json:"chainId"`
+	Name        string `json:"name"`
+	Symbol      string `json:"symbol"`
+	Decimals    int    `json:"decimals"`
+	LogoURL     string `json:"logoUrl,omitempty"`
+	Price       string `json:"price,omitempty"`
+	CoingeckoID string `json:"coingeckoId,omitempty"`
+}
+
+type LiquiditySupplyData struct {
+	ModeWithInterest       bool   `json:"modeWithInterest"`
+	Supply                 string `json:"supply"`
+	WithdrawalLimit        string `json:"withdrawalLimit"`
+	LastUpdateTimestamp    string `json:"lastUpdateTimestamp"`
+	ExpandPercent          string `json:"expandPercent"`
+	ExpandDuration         string `json:"expandDuration"`
+	BaseWithdrawalLimit    string `json:"baseWithdrawalLimit"`
+	WithdrawableUntilLimit string `json:"withdrawableUntilLimit"`
+	Withdrawable           string `json:"withdrawable"`
+}
+
+type EarnToken struct {
+	ID                  int                  `json:"id"`
+	Address             Pubkey               `json:"address"`
+	Name                string               `json:"name"`
+	Symbol              string               `json:"symbol"`
+	Decimals            int                  `json:"decimals"`
+	AssetAddress        Pubkey               `json:"assetAddress"`
+	Asset               LendAsset            `json:"asset"`
+	TotalAssets         Amount               `json:"totalAssets"`
+	TotalSupply         Amount               `json:"totalSupply"`
+	ConvertToShares     string               `json:"convertToShares"`
+	ConvertToAssets     string               `json:"convertToAssets"`
+	RewardsRate         string               `json:"rewardsRate"`
+	SupplyRate          string               `json:"supplyRate"`
+	TotalRate           string               `json:"totalRate"`
+	RebalanceDifference string               `json:"rebalanceDifference,omitempty"`
+	LiquiditySupplyData *LiquiditySupplyData `json:"liquiditySupplyData,omitempty"`
+}
+
+func (c *Client) GetEarnTokens(ctx context.Context) ([]EarnToken, error) {
+	request := NewRequest(c.Url("/lend/v1/earn/tokens"), url.Values{})
+	var response []EarnToken
+	_, err := c.doCall(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
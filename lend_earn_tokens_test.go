@@ -0,0 +1,59 @@
+package jupiter
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetEarnTokens(t *testing.T) {
+	tokens := []EarnToken{
+		{
+			ID:           1,
+			Address:      testMint,
+			Name:         "Jupiter Lend USDC",
+			Symbol:       "jlUSDC",
+			Decimals:     6,
+			AssetAddress: USDC,
+			Asset:        LendAsset{Address: USDC, Symbol: "USDC", Decimals: 6, Price: "1.0001"},
+			TotalAssets:  NewAmount(500000000000),
+			SupplyRate:   "450",
+			RewardsRate:  "120",
+			TotalRate:    "570",
+			LiquiditySupplyData: &LiquiditySupplyData{
+				ModeWithInterest: true,
+				Withdrawable:     "100000000",
+			},
+		},
+	}
+
+	server := newTestServer(t, jsonHandler(t, http.MethodGet, "/lend/v1/earn/tokens", tokens))
+	client := newTestClient(server.URL)
+
+	result, err := client.GetEarnTokens(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 1 {
+		t.Fatalf("expected 1 token, got %d", len(result))
+	}
+	if result[0].Asset.Symbol != "USDC" {
+		t.Errorf("expected asset symbol USDC, got %s", result[0].Asset.Symbol)
+	}
+	if result[0].TotalRate != "570" {
+		t.Errorf("expected totalRate 570, got %s", result[0].TotalRate)
+	}
+	if result[0].LiquiditySupplyData == nil || !result[0].LiquiditySupplyData.ModeWithInterest {
+		t.Errorf("expected liquiditySupplyData.modeWithInterest true, got %v", result[0].LiquiditySupplyData)
+	}
+}
+
+func TestGetEarnTokens_Error(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusInternalServerError, "error"))
+	client := newTestClient(server.URL)
+
+	_, err := client.GetEarnTokens(context.Background())
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
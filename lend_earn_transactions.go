@@ -0,0 +1,56 @@
+package jupiter
+
+import (
+	"context"
+)
+
+type EarnAmountRequest struct {
+	Asset  Pubkey `json:"asset"`
+	Signer Pubkey `json:"signer"`
+	Amount Amount `json:"amount"`
+}
+
+type EarnSharesRequest struct {
+	Asset  Pubkey `json:"asset"`
+	Signer Pubkey `json:"signer"`
+	Shares Amount `json:"shares"`
+}
+
+type EarnTransactionResponse struct {
+	Transaction string `json:"transaction"`
+}
+
+func (c *Client) EarnDeposit(ctx context.Context, body EarnAmountRequest) (*EarnTransactionResponse, error) {
+	return c.earnTransaction(ctx, "/lend/v1/earn/deposit", body.Asset, body.Signer, body)
+}
+
+func (c *Client) EarnWithdraw(ctx context.Context, body EarnAmountRequest) (*EarnTransactionResponse, error) {
+	return c.earnTransaction(ctx, "/lend/v1/earn/withdraw", body.Asset, body.Signer, body)
+}
+
+func (c *Client) EarnMint(ctx context.Context, body EarnSharesRequest) (*EarnTransactionResponse, error) {
+	return c.earnTransaction(ctx, "/lend/v1/earn/mint", body.Asset, body.Signer, body)
+}
+
+func (c *Client) EarnRedeem(ctx context.Context, body EarnSharesRequest) (*EarnTransactionResponse, error) {
+	return c.earnTransaction(ctx, "/lend/v1/earn/redeem", body.Asset, body.Signer, body)
+}
+
+func (c *Client) earnTransaction(ctx context.Context, endpoint string, asset, signer Pubkey, body any) (*EarnTransactionResponse, error) {
+	if err := requirePubkey("asset", asset); err != nil {
+		return nil, err
+	}
+	if err := requirePubkey("signer", signer); err != nil {
+		return nil, err
+	}
+	request, err := NewPostRequest(c.Url(endpoint), body)
+	if err != nil {
+		return nil, err
+	}
+	var response EarnTransactionResponse
+	_, err = c.doCall(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
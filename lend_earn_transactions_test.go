@@ -0,0 +1,81 @@
+package jupiter
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestEarnTransactions(t *testing.T) {
+	amountBody := EarnAmountRequest{Asset: USDC, Signer: testUser, Amount: NewAmount(1000000)}
+	sharesBody := EarnSharesRequest{Asset: USDC, Signer: testUser, Shares: NewAmount(990000)}
+
+	tests := []struct {
+		name string
+		path string
+		call func(c *Client) (*EarnTransactionResponse, error)
+		want map[string]string
+	}{
+		{
+			"deposit", "/lend/v1/earn/deposit",
+			func(c *Client) (*EarnTransactionResponse, error) {
+				return c.EarnDeposit(context.Background(), amountBody)
+			},
+			map[string]string{"asset": USDC.String(), "signer": testUser.String(), "amount": "1000000"},
+		},
+		{
+			"withdraw", "/lend/v1/earn/withdraw",
+			func(c *Client) (*EarnTransactionResponse, error) {
+				return c.EarnWithdraw(context.Background(), amountBody)
+			},
+			map[string]string{"asset": USDC.String(), "signer": testUser.String(), "amount": "1000000"},
+		},
+		{
+			"mint", "/lend/v1/earn/mint",
+			func(c *Client) (*EarnTransactionResponse, error) { return c.EarnMint(context.Background(), sharesBody) },
+			map[string]string{"asset": USDC.String(), "signer": testUser.String(), "shares": "990000"},
+		},
+		{
+			"redeem", "/lend/v1/earn/redeem",
+			func(c *Client) (*EarnTransactionResponse, error) {
+				return c.EarnRedeem(context.Background(), sharesBody)
+			},
+			map[string]string{"asset": USDC.String(), "signer": testUser.String(), "shares": "990000"},
+		},
+	}
+
+	for _, tt := range tests {
+		server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			var req map[string]string
+			json.Unmarshal(body, &req)
+			for k, v := range tt.want {
+				if req[k] != v {
+					t.Errorf("%s: expected %s=%s, got %s", tt.name, k, v, req[k])
+				}
+			}
+			jsonHandler(t, http.MethodPost, tt.path, EarnTransactionResponse{Transaction: tt.name + "-tx"})(w, r)
+		})
+		client := newTestClient(server.URL)
+
+		result, err := tt.call(client)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if result.Transaction != tt.name+"-tx" {
+			t.Errorf("%s: expected transaction %s-tx, got %s", tt.name, tt.name, result.Transaction)
+		}
+	}
+}
+
+func TestEarnDeposit_Error(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusBadRequest, "insufficient balance"))
+	client := newTestClient(server.URL)
+
+	_, err := client.EarnDeposit(context.Background(), EarnAmountRequest{Asset: USDC, Signer: testUser})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
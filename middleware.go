@@ -0,0 +1,122 @@
+package jupiter
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/time/rate"
+)
+
+// Call is a single attempt travelling through the middleware chain.
+// Endpoint is the endpoint path relative to the client's base URL, such as
+// "/swap/v1/quote". Target is the value the response body is decoded into;
+// it is populated once the innermost handler returns without error.
+// Idempotent mirrors Request.Idempotent. SelfHosted is set by
+// FailoverMiddleware when the attempt targets a self-hosted BaseURL.
+type Call struct {
+	Endpoint   string
+	Request    *http.Request
+	Target     any
+	Idempotent bool
+	SelfHosted bool
+}
+
+// Handler executes a call. On API errors it returns the http.Response
+// together with the *APIError.
+type Handler func(call *Call) (*http.Response, error)
+
+// Middleware wraps a Handler. A middleware may modify call.Request before
+// calling next, inspect the response and call.Target afterwards, or skip
+// next entirely, in which case it is responsible for filling call.Target.
+type Middleware func(next Handler) Handler
+
+// WithMiddleware appends middlewares to the client. The first middleware
+// registered is the outermost one.
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(c *Client) {
+		c.Use(middlewares...)
+	}
+}
+
+func (c *Client) Use(middlewares ...Middleware) {
+	c.middlewares = append(c.middlewares, middlewares...)
+}
+
+// RateLimitMiddleware waits on limiter before every attempt.
+func RateLimitMiddleware(limiter *rate.Limiter) Middleware {
+	return func(next Handler) Handler {
+		return func(call *Call) (*http.Response, error) {
+			if limiter != nil {
+				if err := limiter.Wait(call.Request.Context()); err != nil {
+					return nil, err
+				}
+			}
+			return next(call)
+		}
+	}
+}
+
+// APIKeyMiddleware sets the x-api-key header when key is not empty.
+func APIKeyMiddleware(key string) Middleware {
+	return func(next Handler) Handler {
+		return func(call *Call) (*http.Response, error) {
+			if key != "" {
+				call.Request.Header.Set(ApiKeyHeader, key)
+			}
+			return next(call)
+		}
+	}
+}
+
+// handler assembles the chain: user middlewares, then the built-in circuit
+// breaker, failover, rate limit and API key middlewares, then send.
+// Failover sits above the rate limit and API key middlewares so every base
+// URL it tries is charged a token and a key, except self-hosted ones, which
+// skip both. The built-ins read the client fields on every call so Limiter
+// and ApiKey can still be swapped at runtime.
+func (c *Client) handler() Handler {
+	h := Handler(c.send)
+	h = func(next Handler) Handler {
+		return func(call *Call) (*http.Response, error) {
+			if call.SelfHosted {
+				return next(call)
+			}
+			if c.KeyPool != nil {
+				return KeyPoolMiddleware(c.KeyPool)(next)(call)
+			}
+			return APIKeyMiddleware(c.ApiKey)(next)(call)
+		}
+	}(h)
+	h = func(next Handler) Handler {
+		return func(call *Call) (*http.Response, error) {
+			if call.SelfHosted {
+				return next(call)
+			}
+			if c.RateLimiter != nil {
+				return AdaptiveRateLimitMiddleware(c.RateLimiter)(next)(call)
+			}
+			return RateLimitMiddleware(c.Limiter)(next)(call)
+		}
+	}(h)
+	if c.Failover != nil {
+		h = FailoverMiddleware(c.Failover)(h)
+	}
+	if c.Breakers != nil {
+		h = CircuitBreakerMiddleware(c.Breakers)(h)
+	}
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		h = c.middlewares[i](h)
+	}
+	return h
+}
+
+func (c *Client) endpointName(req *Request) string {
+	if endpoint, ok := strings.CutPrefix(req.Endpoint, c.ApiUrl); ok {
+		return endpoint
+	}
+	if u, err := url.Parse(req.Endpoint); err == nil {
+		return u.Path
+	}
+	return req.Endpoint
+}
@@ -0,0 +1,207 @@
+package jupiter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"golang.org/x/time/rate"
+)
+
+func TestMiddleware_Order(t *testing.T) {
+	server := newTestServer(t, jsonHandler(t, http.MethodGet, "/ultra/v1/order/routers", RoutersResponse{}))
+	client := newTestClient(server.URL)
+
+	var order []string
+	record := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(call *Call) (*http.Response, error) {
+				order = append(order, name+" before")
+				resp, err := next(call)
+				order = append(order, name+" after")
+				return resp, err
+			}
+		}
+	}
+	client.Use(record("outer"), record("inner"))
+
+	_, err := client.GetRouters(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{"outer before", "inner before", "inner after", "outer after"}
+	if len(order) != len(want) {
+		t.Fatalf("expected %v, got %v", want, order)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Errorf("expected %v, got %v", want, order)
+			break
+		}
+	}
+}
+
+func TestMiddleware_SeesEndpointAndDecodedTarget(t *testing.T) {
+	routers := RoutersResponse{{ID: "router1", Name: "Jupiter"}}
+	server := newTestServer(t, jsonHandler(t, http.MethodGet, "/ultra/v1/order/routers", routers))
+
+	var (
+		endpoint string
+		decoded  RoutersResponse
+		status   int
+	)
+	client := NewClient(server.URL, "test-api-key",
+		WithLimiter(rate.NewLimiter(rate.Inf, 1)),
+		WithMiddleware(func(next Handler) Handler {
+			return func(call *Call) (*http.Response, error) {
+				endpoint = call.Endpoint
+				resp, err := next(call)
+				if err == nil {
+					status = resp.StatusCode
+					decoded = *call.Target.(*RoutersResponse)
+				}
+				return resp, err
+			}
+		}),
+	)
+
+	_, err := client.GetRouters(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if endpoint != "/ultra/v1/order/routers" {
+		t.Errorf("expected endpoint /ultra/v1/order/routers, got %s", endpoint)
+	}
+	if status != http.StatusOK {
+		t.Errorf("expected status 200, got %d", status)
+	}
+	if len(decoded) != 1 || decoded[0].Name != "Jupiter" {
+		t.Errorf("expected decoded routers, got %v", decoded)
+	}
+}
+
+func TestMiddleware_HeaderInjection(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			t.Errorf("expected Authorization header, got %s", r.Header.Get("Authorization"))
+		}
+		jsonHandler(t, http.MethodGet, "/ultra/v1/order/routers", RoutersResponse{})(w, r)
+	})
+	client := newTestClient(server.URL)
+	client.Use(func(next Handler) Handler {
+		return func(call *Call) (*http.Response, error) {
+			call.Request.Header.Set("Authorization", "Bearer token")
+			return next(call)
+		}
+	})
+
+	_, err := client.GetRouters(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestMiddleware_ShortCircuit(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	})
+	client := newTestClient(server.URL)
+	client.Use(func(next Handler) Handler {
+		return func(call *Call) (*http.Response, error) {
+			*call.Target.(*ProgramIDToLabelResponse) = ProgramIDToLabelResponse{"prog1": "Cached"}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewReader(nil)),
+				Request:    call.Request,
+			}, nil
+		}
+	})
+
+	result, err := client.GetProgramIDToLabel(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result["prog1"] != "Cached" {
+		t.Errorf("expected cached label, got %v", result)
+	}
+}
+
+func TestMiddleware_FaultInjection(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	})
+	client := newTestClient(server.URL)
+	injected := errors.New("injected fault")
+	client.Use(func(next Handler) Handler {
+		return func(call *Call) (*http.Response, error) {
+			return nil, injected
+		}
+	})
+
+	_, err := client.GetRouters(context.Background())
+	if !errors.Is(err, injected) {
+		t.Fatalf("expected injected fault, got %v", err)
+	}
+}
+
+func TestMiddleware_SeesAPIError(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusTooManyRequests, "slow down"))
+	client := newTestClient(server.URL)
+
+	var status int
+	client.Use(func(next Handler) Handler {
+		return func(call *Call) (*http.Response, error) {
+			resp, err := next(call)
+			if resp != nil {
+				status = resp.StatusCode
+			}
+			return resp, err
+		}
+	})
+
+	_, err := client.GetRouters(context.Background())
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	if status != http.StatusTooManyRequests {
+		t.Errorf("expected middleware to see status 429, got %d", status)
+	}
+}
+
+func TestAPIKeyMiddleware_UsesCurrentKey(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(ApiKeyHeader) != "rotated-key" {
+			t.Errorf("expected api key rotated-key, got %s", r.Header.Get(ApiKeyHeader))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[]`))
+	})
+	client := newTestClient(server.URL)
+	client.ApiKey = "rotated-key"
+
+	_, err := client.GetRouters(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestRateLimitMiddleware_CancelledContext(t *testing.T) {
+	limiter := rate.NewLimiter(rate.Limit(0.001), 0)
+	h := RateLimitMiddleware(limiter)(func(call *Call) (*http.Response, error) {
+		t.Error("expected next not to be called")
+		return nil, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://example.com", nil)
+
+	_, err := h(&Call{Request: req})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,81 @@
+package jupiter
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// LiteURL is the keyless Jupiter API host.
+const LiteURL = "https://lite-api.jup.ag"
+
+type Option func(*Client)
+
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.c = httpClient
+	}
+}
+
+// WithTransport sets the RoundTripper of the client's http.Client. The
+// client is copied first so http.DefaultClient is never modified.
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) {
+		httpClient := *c.c
+		httpClient.Transport = transport
+		c.c = &httpClient
+	}
+}
+
+// WithTimeout bounds every HTTP attempt, excluding time spent waiting on
+// the rate limiter and between retries.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+func WithHeader(key, value string) Option {
+	return func(c *Client) {
+		c.headers.Set(key, value)
+	}
+}
+
+func WithRateLimit(every time.Duration, burst int) Option {
+	return func(c *Client) {
+		c.Limiter = rate.NewLimiter(rate.Every(every), burst)
+	}
+}
+
+func WithLimiter(limiter *rate.Limiter) Option {
+	return func(c *Client) {
+		c.Limiter = limiter
+	}
+}
+
+func WithRetryPolicy(policy *RetryPolicy) Option {
+	return func(c *Client) {
+		c.Retry = policy
+	}
+}
+
+func WithBaseURL(url string) Option {
+	return func(c *Client) {
+		c.ApiUrl = url
+	}
+}
+
+// WithLiteAPI targets the keyless lite host and drops any API key.
+func WithLiteAPI() Option {
+	return func(c *Client) {
+		c.ApiUrl = LiteURL
+		c.ApiKey = ""
+	}
+}
@@ -0,0 +1,130 @@
+package jupiter
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestNewClient_Options(t *testing.T) {
+	httpClient := &http.Client{}
+	limiter := rate.NewLimiter(rate.Inf, 1)
+	policy := DefaultRetryPolicy()
+
+	client := NewClient(DefaultURL, "my-key",
+		WithHTTPClient(httpClient),
+		WithLimiter(limiter),
+		WithRetryPolicy(policy),
+		WithTimeout(time.Second),
+		WithBaseURL("https://quote.internal"),
+	)
+
+	if client.c != httpClient {
+		t.Error("expected custom http client")
+	}
+	if client.Limiter != limiter {
+		t.Error("expected custom limiter")
+	}
+	if client.Retry != policy {
+		t.Error("expected custom retry policy")
+	}
+	if client.timeout != time.Second {
+		t.Errorf("expected timeout 1s, got %v", client.timeout)
+	}
+	if client.ApiUrl != "https://quote.internal" {
+		t.Errorf("expected ApiUrl https://quote.internal, got %s", client.ApiUrl)
+	}
+	if client.ApiKey != "my-key" {
+		t.Errorf("expected ApiKey my-key, got %s", client.ApiKey)
+	}
+}
+
+func TestNewClient_WithLiteAPI(t *testing.T) {
+	client := NewClient(DefaultURL, "my-key", WithLiteAPI())
+
+	if client.ApiUrl != LiteURL {
+		t.Errorf("expected ApiUrl %s, got %s", LiteURL, client.ApiUrl)
+	}
+	if client.ApiKey != "" {
+		t.Errorf("expected empty ApiKey, got %s", client.ApiKey)
+	}
+}
+
+func TestNewClient_WithRateLimit(t *testing.T) {
+	client := NewClient(DefaultURL, "", WithRateLimit(50*time.Millisecond, 5))
+
+	if client.Limiter.Limit() != rate.Every(50*time.Millisecond) {
+		t.Errorf("expected limit every 50ms, got %v", client.Limiter.Limit())
+	}
+	if client.Limiter.Burst() != 5 {
+		t.Errorf("expected burst 5, got %d", client.Limiter.Burst())
+	}
+}
+
+func TestNewClient_WithTransport(t *testing.T) {
+	var called bool
+	transport := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		called = true
+		return nil, errors.New("offline")
+	})
+	client := NewClient("http://jupiter.invalid", "", WithTransport(transport))
+	client.Limiter = rate.NewLimiter(rate.Inf, 1)
+
+	if http.DefaultClient.Transport != nil {
+		t.Fatal("expected http.DefaultClient to be left untouched")
+	}
+
+	_, err := client.GetRouters(context.Background())
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !called {
+		t.Error("expected custom transport to be used")
+	}
+}
+
+func TestDoCall_HeadersAndUserAgent(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("User-Agent") != "pricing-worker/1.0" {
+			t.Errorf("expected User-Agent pricing-worker/1.0, got %s", r.Header.Get("User-Agent"))
+		}
+		if r.Header.Get("X-Request-Source") != "treasury" {
+			t.Errorf("expected X-Request-Source treasury, got %s", r.Header.Get("X-Request-Source"))
+		}
+		jsonHandler(t, http.MethodGet, "/ultra/v1/order/routers", RoutersResponse{})(w, r)
+	})
+	client := NewClient(server.URL, "test-api-key",
+		WithLimiter(rate.NewLimiter(rate.Inf, 1)),
+		WithUserAgent("pricing-worker/1.0"),
+		WithHeader("X-Request-Source", "treasury"),
+	)
+
+	_, err := client.GetRouters(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestDoCall_Timeout(t *testing.T) {
+	release := make(chan struct{})
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	})
+	defer close(release)
+	client := NewClient(server.URL, "",
+		WithLimiter(rate.NewLimiter(rate.Inf, 1)),
+		WithTimeout(20*time.Millisecond),
+	)
+
+	_, err := client.GetRouters(context.Background())
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
@@ -3,12 +3,18 @@ package jupiter
 import (
 	"context"
 	"net/url"
+	"strings"
+	"sync"
 )
 
+// PriceV3MaxIDs is the maximum number of mints accepted by a single
+// Price V3 request.
+const PriceV3MaxIDs = 50
+
 type PriceV3Entry struct {
-	USDPrice       float64 `json:"usdPrice"`
-	BlockID        *int64  `json:"blockId,omitempty"`
-	Decimals       *int    `json:"decimals,omitempty"`
+	USDPrice       Decimal  `json:"usdPrice"`
+	BlockID        *int64   `json:"blockId,omitempty"`
+	Decimals       *int     `json:"decimals,omitempty"`
 	PriceChange24h *float64 `json:"priceChange24h,omitempty"`
 }
 
@@ -26,3 +32,74 @@ func (c *Client) GetPrices(ctx context.Context, ids string) (PriceV3Response, er
 	}
 	return response, nil
 }
+
+type PricesBatchResponse struct {
+	Prices PriceV3Response
+	// Missing lists the requested mints the API returned no price for.
+	Missing []string
+}
+
+// GetPricesBatch prices any number of mints. Duplicates are dropped, the
+// remaining mints are split into chunks of PriceV3MaxIDs and fetched
+// concurrently, each request still waiting on the client's limiter. The
+// first failing chunk cancels the others and its error is returned.
+func (c *Client) GetPricesBatch(ctx context.Context, mints []string) (*PricesBatchResponse, error) {
+	ids := make([]string, 0, len(mints))
+	seen := make(map[string]bool, len(mints))
+	for _, mint := range mints {
+		if mint == "" || seen[mint] {
+			continue
+		}
+		seen[mint] = true
+		ids = append(ids, mint)
+	}
+
+	var chunks [][]string
+	for start := 0; start < len(ids); start += PriceV3MaxIDs {
+		end := min(start+PriceV3MaxIDs, len(ids))
+		chunks = append(chunks, ids[start:end])
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]PriceV3Response, len(chunks))
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	for i, chunk := range chunks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			response, err := c.GetPrices(ctx, strings.Join(chunk, ","))
+			if err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			results[i] = response
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	batch := &PricesBatchResponse{Prices: make(PriceV3Response, len(ids))}
+	for _, response := range results {
+		for mint, entry := range response {
+			batch.Prices[mint] = entry
+		}
+	}
+	for _, mint := range ids {
+		if _, ok := batch.Prices[mint]; !ok {
+			batch.Missing = append(batch.Missing, mint)
+		}
+	}
+	return batch, nil
+}
@@ -3,7 +3,10 @@ package jupiter
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
+	"sync"
 	"testing"
 )
 
@@ -14,13 +17,13 @@ func TestGetPrices(t *testing.T) {
 
 	prices := PriceV3Response{
 		"SOL": {
-			USDPrice:       150.25,
+			USDPrice:       testDecimal("150.25"),
 			BlockID:        &blockID,
 			Decimals:       &decimals,
 			PriceChange24h: &change,
 		},
 		"USDC": {
-			USDPrice: 1.0,
+			USDPrice: testDecimal("1"),
 		},
 	}
 
@@ -48,8 +51,8 @@ func TestGetPrices(t *testing.T) {
 	if !ok {
 		t.Fatal("expected SOL price entry")
 	}
-	if solPrice.USDPrice != 150.25 {
-		t.Errorf("expected SOL price 150.25, got %f", solPrice.USDPrice)
+	if solPrice.USDPrice.String() != "150.25" {
+		t.Errorf("expected SOL price 150.25, got %s", solPrice.USDPrice)
 	}
 	if *solPrice.BlockID != 123456 {
 		t.Errorf("expected block ID 123456, got %d", *solPrice.BlockID)
@@ -62,8 +65,8 @@ func TestGetPrices(t *testing.T) {
 	if !ok {
 		t.Fatal("expected USDC price entry")
 	}
-	if usdcPrice.USDPrice != 1.0 {
-		t.Errorf("expected USDC price 1.0, got %f", usdcPrice.USDPrice)
+	if usdcPrice.USDPrice.String() != "1" {
+		t.Errorf("expected USDC price 1, got %s", usdcPrice.USDPrice)
 	}
 }
 
@@ -76,3 +79,97 @@ func TestGetPrices_Error(t *testing.T) {
 		t.Fatal("expected error")
 	}
 }
+
+func TestGetPricesBatch(t *testing.T) {
+	var mints []string
+	for i := 0; i < 120; i++ {
+		mints = append(mints, fmt.Sprintf("mint%03d", i))
+	}
+	// duplicates and empty ids must not produce extra lookups
+	mints = append(mints, "mint000", "mint001", "")
+
+	var (
+		mu       sync.Mutex
+		requests int
+		seen     = map[string]int{}
+	)
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		ids := strings.Split(r.URL.Query().Get("ids"), ",")
+		if len(ids) > PriceV3MaxIDs {
+			t.Errorf("expected at most %d ids per request, got %d", PriceV3MaxIDs, len(ids))
+		}
+
+		prices := PriceV3Response{}
+		mu.Lock()
+		requests++
+		for _, id := range ids {
+			seen[id]++
+			if id != "mint007" && id != "mint110" {
+				prices[id] = PriceV3Entry{USDPrice: testDecimal("1.5")}
+			}
+		}
+		mu.Unlock()
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(prices)
+	})
+	client := newTestClient(server.URL)
+
+	result, err := client.GetPricesBatch(context.Background(), mints)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+	for id, count := range seen {
+		if count != 1 {
+			t.Errorf("expected %s to be requested once, got %d", id, count)
+		}
+	}
+	if len(result.Prices) != 118 {
+		t.Errorf("expected 118 prices, got %d", len(result.Prices))
+	}
+	if result.Prices["mint119"].USDPrice.String() != "1.5" {
+		t.Errorf("expected mint119 price 1.5, got %s", result.Prices["mint119"].USDPrice)
+	}
+	if len(result.Missing) != 2 || result.Missing[0] != "mint007" || result.Missing[1] != "mint110" {
+		t.Errorf("expected missing [mint007 mint110], got %v", result.Missing)
+	}
+}
+
+func TestGetPricesBatch_Empty(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	})
+	client := newTestClient(server.URL)
+
+	result, err := client.GetPricesBatch(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Prices) != 0 || len(result.Missing) != 0 {
+		t.Errorf("expected empty result, got %v", result)
+	}
+}
+
+func TestGetPricesBatch_Error(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Query().Get("ids"), "mint060") {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(PriceV3Response{})
+	})
+	client := newTestClient(server.URL)
+
+	var mints []string
+	for i := 0; i < 100; i++ {
+		mints = append(mints, fmt.Sprintf("mint%03d", i))
+	}
+	_, err := client.GetPricesBatch(context.Background(), mints)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,210 @@
+package jupiter
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const PubkeyLength = 32
+
+// Pubkey is a Solana public key. It marshals to and from its base58 text
+// form. The zero Pubkey means "not set": it marshals to an empty string,
+// which decodes back to it, and is distinct from SystemProgram, whose key
+// bytes are all zero.
+type Pubkey struct {
+	key [PubkeyLength]byte
+	set bool
+}
+
+var (
+	SystemProgram    = MustParsePubkey("11111111111111111111111111111111")
+	TokenProgram     = MustParsePubkey("TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA")
+	Token2022Program = MustParsePubkey("TokenzQdBNbLqP5VEhdkAS6EPFLC1PHnBqCXEpPxuEb")
+	WrappedSOL       = MustParsePubkey("So11111111111111111111111111111111111111112")
+	USDC             = MustParsePubkey("EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v")
+	USDT             = MustParsePubkey("Es9vMFrzaCERmJfrF4H2FYD4KCoNkY11McCe8BenwNYB")
+)
+
+var ErrInvalidPubkey = errors.New("invalid public key")
+
+func ParsePubkey(s string) (Pubkey, error) {
+	decoded, err := base58Decode(s)
+	if err != nil {
+		return Pubkey{}, fmt.Errorf("%w %q: %v", ErrInvalidPubkey, s, err)
+	}
+	if len(decoded) != PubkeyLength {
+		return Pubkey{}, fmt.Errorf("%w %q: decodes to %d bytes, want %d", ErrInvalidPubkey, s, len(decoded), PubkeyLength)
+	}
+	var key [PubkeyLength]byte
+	copy(key[:], decoded)
+	return PubkeyFromBytes(key), nil
+}
+
+func PubkeyFromBytes(key [PubkeyLength]byte) Pubkey {
+	return Pubkey{key: key, set: true}
+}
+
+// MustParsePubkey is like ParsePubkey but panics on invalid input. It is
+// meant for constants.
+func MustParsePubkey(s string) Pubkey {
+	key, err := ParsePubkey(s)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+func (p Pubkey) Bytes() [PubkeyLength]byte {
+	return p.key
+}
+
+// String returns the base58 form of p, or "" when p is not set.
+func (p Pubkey) String() string {
+	if !p.set {
+		return ""
+	}
+	return base58Encode(p.key[:])
+}
+
+// IsZero reports whether p is not set.
+func (p Pubkey) IsZero() bool {
+	return !p.set
+}
+
+func (p Pubkey) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+func (p *Pubkey) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*p = Pubkey{}
+		return nil
+	}
+	key, err := ParsePubkey(string(text))
+	if err != nil {
+		return err
+	}
+	*p = key
+	return nil
+}
+
+// FieldError reports a request field rejected before any HTTP call was
+// made. Field is the name the API uses for it.
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("invalid %s: %v", e.Field, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+var ErrMissingPubkey = errors.New("public key is required")
+
+func requirePubkey(field string, key Pubkey) error {
+	if key.IsZero() {
+		return &FieldError{Field: field, Err: ErrMissingPubkey}
+	}
+	return nil
+}
+
+// requirePubkeys checks that every key in keys is set, naming the first
+// missing one as field[i].
+func requirePubkeys(field string, keys []Pubkey) error {
+	for i, key := range keys {
+		if err := requirePubkey(fmt.Sprintf("%s[%d]", field, i), key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// joinPubkeys returns keys as a comma-separated list for query parameters.
+func joinPubkeys(keys []Pubkey) string {
+	encoded := make([]string, len(keys))
+	for i, key := range keys {
+		encoded[i] = key.String()
+	}
+	return strings.Join(encoded, ",")
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var base58Index = func() [256]int8 {
+	var index [256]int8
+	for i := range index {
+		index[i] = -1
+	}
+	for i := 0; i < len(base58Alphabet); i++ {
+		index[base58Alphabet[i]] = int8(i)
+	}
+	return index
+}()
+
+func base58Encode(data []byte) string {
+	zeros := 0
+	for zeros < len(data) && data[zeros] == 0 {
+		zeros++
+	}
+	// log(256) / log(58) < 1.37
+	digits := make([]byte, 0, len(data)*137/100+1)
+	for _, b := range data[zeros:] {
+		carry := int(b)
+		for i := range digits {
+			carry += int(digits[i]) << 8
+			digits[i] = byte(carry % 58)
+			carry /= 58
+		}
+		for carry > 0 {
+			digits = append(digits, byte(carry%58))
+			carry /= 58
+		}
+	}
+
+	out := make([]byte, zeros+len(digits))
+	for i := 0; i < zeros; i++ {
+		out[i] = base58Alphabet[0]
+	}
+	for i, d := range digits {
+		out[len(out)-1-i] = base58Alphabet[d]
+	}
+	return string(out)
+}
+
+func base58Decode(s string) ([]byte, error) {
+	if s == "" {
+		return nil, errors.New("empty string")
+	}
+	zeros := 0
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+	bytes := make([]byte, 0, len(s)*733/1000+1)
+	for i := zeros; i < len(s); i++ {
+		value := base58Index[s[i]]
+		if value < 0 {
+			return nil, fmt.Errorf("invalid base58 character %q", s[i])
+		}
+		carry := int(value)
+		for j := range bytes {
+			carry += int(bytes[j]) * 58
+			bytes[j] = byte(carry)
+			carry >>= 8
+		}
+		for carry > 0 {
+			bytes = append(bytes, byte(carry))
+			carry >>= 8
+		}
+	}
+
+	out := make([]byte, zeros+len(bytes))
+	for i, b := range bytes {
+		out[len(out)-1-i] = b
+	}
+	return out, nil
+}
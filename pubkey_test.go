@@ -0,0 +1,188 @@
+package jupiter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+)
+
+func TestParsePubkey_RoundTrip(t *testing.T) {
+	tests := []string{
+		"11111111111111111111111111111111",
+		"So11111111111111111111111111111111111111112",
+		"EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v",
+		"Es9vMFrzaCERmJfrF4H2FYD4KCoNkY11McCe8BenwNYB",
+		"TokenzQdBNbLqP5VEhdkAS6EPFLC1PHnBqCXEpPxuEb",
+		"TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA",
+	}
+	for _, s := range tests {
+		key, err := ParsePubkey(s)
+		if err != nil {
+			t.Fatalf("ParsePubkey(%q): %v", s, err)
+		}
+		if key.String() != s {
+			t.Errorf("String() = %q, want %q", key.String(), s)
+		}
+	}
+	if SystemProgram.IsZero() || SystemProgram == (Pubkey{}) {
+		t.Error("expected system program to be distinct from the unset key")
+	}
+	if SystemProgram.Bytes() != [PubkeyLength]byte{} {
+		t.Errorf("expected system program bytes to be zero, got %x", SystemProgram.Bytes())
+	}
+	if err := requirePubkey("maker", SystemProgram); err != nil {
+		t.Errorf("expected system program to count as set, got %v", err)
+	}
+	if b := WrappedSOL.Bytes(); b[0] != 0x06 || b[31] != 0x01 {
+		t.Errorf("unexpected wrapped SOL bytes %x", b)
+	}
+}
+
+func TestParsePubkey_Invalid(t *testing.T) {
+	tests := []string{
+		"",
+		"SOL",
+		"EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGk",
+		"EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1vv",
+		"0PjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v",
+		"OPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v",
+	}
+	for _, s := range tests {
+		if _, err := ParsePubkey(s); !errors.Is(err, ErrInvalidPubkey) {
+			t.Errorf("ParsePubkey(%q) = %v, want ErrInvalidPubkey", s, err)
+		}
+	}
+}
+
+func TestPubkey_JSON(t *testing.T) {
+	type payload struct {
+		Mint     Pubkey   `json:"mint"`
+		Optional Pubkey   `json:"optional,omitzero"`
+		Orders   []Pubkey `json:"orders"`
+	}
+
+	data, err := json.Marshal(payload{Mint: USDC, Orders: []Pubkey{USDT}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `{"mint":"EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v","orders":["Es9vMFrzaCERmJfrF4H2FYD4KCoNkY11McCe8BenwNYB"]}`
+	if string(data) != want {
+		t.Errorf("got %s, want %s", data, want)
+	}
+
+	var decoded payload
+	if err := json.Unmarshal([]byte(`{"mint":"So11111111111111111111111111111111111111112","optional":"","orders":[]}`), &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decoded.Mint != WrappedSOL || !decoded.Optional.IsZero() {
+		t.Errorf("unexpected decoded payload %+v", decoded)
+	}
+
+	data, err = json.Marshal(payload{Optional: SystemProgram})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want = `{"mint":"","optional":"11111111111111111111111111111111","orders":null}`
+	if string(data) != want {
+		t.Errorf("got %s, want %s", data, want)
+	}
+
+	if err := json.Unmarshal([]byte(`{"mint":"not-a-key"}`), &decoded); !errors.Is(err, ErrInvalidPubkey) {
+		t.Errorf("expected ErrInvalidPubkey, got %v", err)
+	}
+}
+
+func TestPubkeyValidation_BeforeHTTPCall(t *testing.T) {
+	var calls atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	})
+	client := newTestClient(server.URL)
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		field string
+		call  func() error
+	}{
+		{"quote input", "inputMint", func() error {
+			_, err := client.GetSwapQuote(ctx, SwapQuoteParams{OutputMint: USDC, Amount: NewAmount(1)})
+			return err
+		}},
+		{"quote same mints", "outputMint", func() error {
+			_, err := client.GetSwapQuote(ctx, SwapQuoteParams{InputMint: USDC, OutputMint: USDC, Amount: NewAmount(1)})
+			return err
+		}},
+		{"quote amount", "amount", func() error {
+			_, err := client.GetSwapQuote(ctx, SwapQuoteParams{InputMint: WrappedSOL, OutputMint: USDC})
+			return err
+		}},
+		{"quote slippage", "slippageBps", func() error {
+			_, err := client.GetSwapQuote(ctx, SwapQuoteParams{InputMint: WrappedSOL, OutputMint: USDC, Amount: NewAmount(1), SlippageBps: MaxBps + 1})
+			return err
+		}},
+		{"create payer", "payer", func() error {
+			_, err := client.CreateOrder(ctx, CreateOrderRequest{InputMint: WrappedSOL, OutputMint: USDC, Maker: testUser})
+			return err
+		}},
+		{"cancel order", "order", func() error {
+			_, err := client.CancelOrder(ctx, CancelOrderRequest{Maker: testUser})
+			return err
+		}},
+		{"cancel orders entry", "orders[1]", func() error {
+			_, err := client.CancelOrders(ctx, CancelOrdersRequest{Maker: testUser, Orders: []Pubkey{testOrder, {}}})
+			return err
+		}},
+		{"trigger orders user", "user", func() error {
+			_, err := client.GetTriggerOrders(ctx, GetTriggerOrdersParams{OrderStatus: "active"})
+			return err
+		}},
+		{"ultra order input", "inputMint", func() error {
+			_, err := client.GetUltraOrder(ctx, UltraOrderParams{OutputMint: USDC, Amount: NewAmount(1)})
+			return err
+		}},
+		{"swap user", "userPublicKey", func() error {
+			_, err := client.BuildSwapTransaction(ctx, SwapRequest{})
+			return err
+		}},
+		{"recurring user", "user", func() error {
+			_, err := client.GetRecurringOrders(ctx, GetRecurringOrdersParams{})
+			return err
+		}},
+		{"earn positions users", "users", func() error {
+			_, err := client.GetEarnPositions(ctx, nil)
+			return err
+		}},
+		{"pending invites address", "address", func() error {
+			_, err := client.GetPendingInvites(ctx, GetSendInvitesParams{})
+			return err
+		}},
+		{"shield mints entry", "mints[0]", func() error {
+			_, err := client.GetShield(ctx, []Pubkey{{}})
+			return err
+		}},
+		{"balances address", "address", func() error {
+			_, err := client.GetBalances(ctx, Pubkey{})
+			return err
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			var fieldErr *FieldError
+			if !errors.As(err, &fieldErr) {
+				t.Fatalf("expected FieldError, got %v", err)
+			}
+			if fieldErr.Field != tt.field {
+				t.Errorf("Field = %q, want %q", fieldErr.Field, tt.field)
+			}
+		})
+	}
+	if calls.Load() != 0 {
+		t.Errorf("expected no HTTP calls, got %d", calls.Load())
+	}
+}
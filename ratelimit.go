@@ -0,0 +1,233 @@
+package jupiter
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// EndpointFamily groups endpoints that share server-side limits, such as
+// every /price/... or /ultra/... endpoint.
+type EndpointFamily string
+
+const (
+	FamilyPrice     EndpointFamily = "price"
+	FamilyUltra     EndpointFamily = "ultra"
+	FamilySwap      EndpointFamily = "swap"
+	FamilyTrigger   EndpointFamily = "trigger"
+	FamilyRecurring EndpointFamily = "recurring"
+	FamilyTokens    EndpointFamily = "tokens"
+	FamilyLend      EndpointFamily = "lend"
+	FamilySend      EndpointFamily = "send"
+	FamilyOther     EndpointFamily = "other"
+)
+
+// EndpointFamilyOf returns the family of an endpoint path such as
+// "/price/v3".
+func EndpointFamilyOf(endpoint string) EndpointFamily {
+	segment, _, _ := strings.Cut(strings.TrimPrefix(endpoint, "/"), "/")
+	switch family := EndpointFamily(segment); family {
+	case FamilyPrice, FamilyUltra, FamilySwap, FamilyTrigger, FamilyRecurring, FamilyTokens, FamilyLend, FamilySend:
+		return family
+	}
+	return FamilyOther
+}
+
+type RateBucket struct {
+	Limit rate.Limit
+	Burst int
+}
+
+// RatePlan describes the buckets of an API plan. Families listed in Buckets
+// get a bucket of their own; every other family shares Default.
+type RatePlan struct {
+	Name    string
+	Default RateBucket
+	Buckets map[EndpointFamily]RateBucket
+}
+
+// newRatePlan builds a plan allowing perTenSeconds requests per ten second
+// window. Price has its own bucket of the same size; Ultra limits scale with
+// swap volume rather than plan, so it starts from the base Ultra allowance.
+func newRatePlan(name string, perTenSeconds int) RatePlan {
+	bucket := RateBucket{Limit: rate.Limit(float64(perTenSeconds) / 10), Burst: max(perTenSeconds/10, 1)}
+	return RatePlan{
+		Name:    name,
+		Default: bucket,
+		Buckets: map[EndpointFamily]RateBucket{
+			FamilyPrice: bucket,
+			FamilyUltra: {Limit: rate.Limit(5), Burst: 5},
+		},
+	}
+}
+
+// RatePlanFree matches keyed free-tier access: 60 requests per minute.
+func RatePlanFree() RatePlan {
+	plan := newRatePlan("free", 10)
+	plan.Buckets[FamilyUltra] = plan.Default
+	return plan
+}
+
+func RatePlanProI() RatePlan   { return newRatePlan("pro-i", 100) }
+func RatePlanProII() RatePlan  { return newRatePlan("pro-ii", 500) }
+func RatePlanProIII() RatePlan { return newRatePlan("pro-iii", 1000) }
+func RatePlanProIV() RatePlan  { return newRatePlan("pro-iv", 5000) }
+
+const (
+	defaultBucketKey = EndpointFamily("")
+	// recoverAfter is the number of consecutive successful responses after
+	// which a throttled bucket raises its limit again.
+	recoverAfter = 10
+)
+
+type bucket struct {
+	limiter     *rate.Limiter
+	ceiling     rate.Limit
+	pausedUntil time.Time
+	successes   int
+}
+
+// AdaptiveLimiter keeps one token bucket per endpoint family. Buckets slow
+// down on 429 responses, pause when the server reports an exhausted window
+// through rate-limit headers, and recover towards the plan limit as
+// requests succeed again.
+type AdaptiveLimiter struct {
+	mu      sync.Mutex
+	plan    RatePlan
+	buckets map[EndpointFamily]*bucket
+}
+
+func NewAdaptiveLimiter(plan RatePlan) *AdaptiveLimiter {
+	l := &AdaptiveLimiter{
+		plan:    plan,
+		buckets: map[EndpointFamily]*bucket{},
+	}
+	l.buckets[defaultBucketKey] = newBucket(plan.Default)
+	for family, config := range plan.Buckets {
+		l.buckets[family] = newBucket(config)
+	}
+	return l
+}
+
+func newBucket(config RateBucket) *bucket {
+	return &bucket{
+		limiter: rate.NewLimiter(config.Limit, max(config.Burst, 1)),
+		ceiling: config.Limit,
+	}
+}
+
+func (l *AdaptiveLimiter) bucket(family EndpointFamily) *bucket {
+	if b, ok := l.buckets[family]; ok {
+		return b
+	}
+	return l.buckets[defaultBucketKey]
+}
+
+// Limit returns the current limit of the bucket serving family.
+func (l *AdaptiveLimiter) Limit(family EndpointFamily) rate.Limit {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.bucket(family).limiter.Limit()
+}
+
+func (l *AdaptiveLimiter) Wait(ctx context.Context, family EndpointFamily) error {
+	l.mu.Lock()
+	b := l.bucket(family)
+	pause := time.Until(b.pausedUntil)
+	l.mu.Unlock()
+
+	if pause > 0 {
+		if err := sleep(ctx, pause); err != nil {
+			return err
+		}
+	}
+	return b.limiter.Wait(ctx)
+}
+
+// Observe adjusts the bucket serving family from a response.
+func (l *AdaptiveLimiter) Observe(family EndpointFamily, httpResponse *http.Response) {
+	if httpResponse == nil {
+		return
+	}
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b := l.bucket(family)
+
+	if httpResponse.StatusCode == http.StatusTooManyRequests {
+		b.successes = 0
+		floor := b.ceiling / 20
+		b.limiter.SetLimitAt(now, max(b.limiter.Limit()/2, floor))
+		if d, ok := retryAfter(httpResponse.Header.Get("Retry-After"), now); ok {
+			b.pause(now.Add(d))
+		}
+	} else if b.limiter.Limit() < b.ceiling {
+		b.successes++
+		if b.successes >= recoverAfter {
+			b.successes = 0
+			b.limiter.SetLimitAt(now, min(b.limiter.Limit()+b.ceiling/10, b.ceiling))
+		}
+	}
+
+	if remaining, ok := rateLimitHeader(httpResponse.Header, "Remaining"); ok && remaining <= 0 {
+		if reset, ok := rateLimitHeader(httpResponse.Header, "Reset"); ok {
+			b.pause(resetTime(reset, now))
+		}
+	}
+}
+
+func (b *bucket) pause(until time.Time) {
+	if until.After(b.pausedUntil) {
+		b.pausedUntil = until
+	}
+}
+
+// rateLimitHeader reads X-RateLimit-<name> or the IETF RateLimit-<name>.
+func rateLimitHeader(header http.Header, name string) (int64, bool) {
+	for _, key := range []string{"X-RateLimit-" + name, "RateLimit-" + name} {
+		if value := header.Get(key); value != "" {
+			n, err := strconv.ParseInt(value, 10, 64)
+			return n, err == nil
+		}
+	}
+	return 0, false
+}
+
+// resetTime interprets a reset header either as a unix timestamp or as
+// seconds from now.
+func resetTime(reset int64, now time.Time) time.Time {
+	if reset > 1_000_000_000 {
+		return time.Unix(reset, 0)
+	}
+	return now.Add(time.Duration(reset) * time.Second)
+}
+
+// AdaptiveRateLimitMiddleware waits on the bucket of the call's endpoint
+// family and feeds the response back into the limiter.
+func AdaptiveRateLimitMiddleware(limiter *AdaptiveLimiter) Middleware {
+	return func(next Handler) Handler {
+		return func(call *Call) (*http.Response, error) {
+			family := EndpointFamilyOf(call.Endpoint)
+			if err := limiter.Wait(call.Request.Context(), family); err != nil {
+				return nil, err
+			}
+			httpResponse, err := next(call)
+			limiter.Observe(family, httpResponse)
+			return httpResponse, err
+		}
+	}
+}
+
+// WithRatePlan replaces the single global limiter with per-family buckets
+// configured from plan.
+func WithRatePlan(plan RatePlan) Option {
+	return func(c *Client) {
+		c.RateLimiter = NewAdaptiveLimiter(plan)
+	}
+}
@@ -0,0 +1,174 @@
+package jupiter
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestEndpointFamilyOf(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		want     EndpointFamily
+	}{
+		{"/price/v3", FamilyPrice},
+		{"/ultra/v1/order", FamilyUltra},
+		{"/swap/v1/quote", FamilySwap},
+		{"/trigger/v1/createOrder", FamilyTrigger},
+		{"/recurring/v1/execute", FamilyRecurring},
+		{"/tokens/v2/search", FamilyTokens},
+		{"/lend/v1/earn/tokens", FamilyLend},
+		{"/send/v1/craft-send", FamilySend},
+		{"/test", FamilyOther},
+		{"", FamilyOther},
+	}
+
+	for _, tt := range tests {
+		if got := EndpointFamilyOf(tt.endpoint); got != tt.want {
+			t.Errorf("EndpointFamilyOf(%q) = %q, want %q", tt.endpoint, got, tt.want)
+		}
+	}
+}
+
+func TestRatePlans(t *testing.T) {
+	tests := []struct {
+		plan RatePlan
+		want rate.Limit
+	}{
+		{RatePlanFree(), 1},
+		{RatePlanProI(), 10},
+		{RatePlanProII(), 50},
+		{RatePlanProIII(), 100},
+		{RatePlanProIV(), 500},
+	}
+
+	for _, tt := range tests {
+		limiter := NewAdaptiveLimiter(tt.plan)
+		if got := limiter.Limit(FamilySwap); got != tt.want {
+			t.Errorf("%s: expected swap limit %v, got %v", tt.plan.Name, tt.want, got)
+		}
+		if got := limiter.Limit(FamilyPrice); got != tt.want {
+			t.Errorf("%s: expected price limit %v, got %v", tt.plan.Name, tt.want, got)
+		}
+	}
+}
+
+func TestAdaptiveLimiter_SeparateBuckets(t *testing.T) {
+	limiter := NewAdaptiveLimiter(RatePlanProI())
+
+	limiter.Observe(FamilyPrice, &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}})
+
+	if got := limiter.Limit(FamilyPrice); got != 5 {
+		t.Errorf("expected price limit halved to 5, got %v", got)
+	}
+	if got := limiter.Limit(FamilySwap); got != 10 {
+		t.Errorf("expected swap limit untouched at 10, got %v", got)
+	}
+	if got := limiter.Limit(FamilyTokens); got != 10 {
+		t.Errorf("expected tokens limit untouched at 10, got %v", got)
+	}
+}
+
+func TestAdaptiveLimiter_BackoffAndRecovery(t *testing.T) {
+	limiter := NewAdaptiveLimiter(RatePlanProI())
+	limited := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+	ok := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+
+	for i := 0; i < 10; i++ {
+		limiter.Observe(FamilySwap, limited)
+	}
+	if got := limiter.Limit(FamilySwap); got != 0.5 {
+		t.Errorf("expected limit floored at 0.5, got %v", got)
+	}
+
+	for i := 0; i < recoverAfter; i++ {
+		limiter.Observe(FamilySwap, ok)
+	}
+	if got := limiter.Limit(FamilySwap); got != 1.5 {
+		t.Errorf("expected limit raised to 1.5, got %v", got)
+	}
+
+	for i := 0; i < 20*recoverAfter; i++ {
+		limiter.Observe(FamilySwap, ok)
+	}
+	if got := limiter.Limit(FamilySwap); got != 10 {
+		t.Errorf("expected limit capped at 10, got %v", got)
+	}
+}
+
+func TestAdaptiveLimiter_PausesOnHeaders(t *testing.T) {
+	tests := []struct {
+		name   string
+		header http.Header
+	}{
+		{"retry after", http.Header{"Retry-After": {"30"}}},
+		{"x-ratelimit reset delta", http.Header{"X-Ratelimit-Remaining": {"0"}, "X-Ratelimit-Reset": {"30"}}},
+		{"ietf reset delta", http.Header{"Ratelimit-Remaining": {"0"}, "Ratelimit-Reset": {"30"}}},
+		{"reset timestamp", http.Header{
+			"X-Ratelimit-Remaining": {"0"},
+			"X-Ratelimit-Reset":     {strconv.FormatInt(time.Now().Add(30*time.Second).Unix(), 10)},
+		}},
+	}
+
+	for _, tt := range tests {
+		limiter := NewAdaptiveLimiter(RatePlanProI())
+		status := http.StatusOK
+		if tt.header.Get("Retry-After") != "" {
+			status = http.StatusTooManyRequests
+		}
+		limiter.Observe(FamilyTokens, &http.Response{StatusCode: status, Header: tt.header})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		err := limiter.Wait(ctx, FamilyTokens)
+		cancel()
+		if err == nil {
+			t.Errorf("%s: expected wait to block until reset", tt.name)
+		}
+		if err := limiter.Wait(context.Background(), FamilyPrice); err != nil {
+			t.Errorf("%s: expected price bucket not to be paused, got %v", tt.name, err)
+		}
+	}
+}
+
+func TestAdaptiveLimiter_RemainingNotExhausted(t *testing.T) {
+	limiter := NewAdaptiveLimiter(RatePlanProI())
+	limiter.Observe(FamilySwap, &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"X-Ratelimit-Remaining": {"4"}, "X-Ratelimit-Reset": {"30"}},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := limiter.Wait(ctx, FamilySwap); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestClient_WithRatePlan(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	client := NewClient(server.URL, "test-api-key", WithRatePlan(RatePlanProI()))
+	// the global limiter must be bypassed once a plan is configured
+	client.Limiter = rate.NewLimiter(rate.Every(time.Hour), 0)
+
+	_, err := client.GetPrices(context.Background(), "SOL")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if got := client.RateLimiter.Limit(FamilyPrice); got != 5 {
+		t.Errorf("expected price limit 5 after 429, got %v", got)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err = client.GetPrices(ctx, "SOL")
+	if err == nil {
+		t.Fatal("expected paused bucket to block until context expires")
+	}
+}
@@ -0,0 +1,35 @@
+package jupiter
+
+import (
+	"context"
+)
+
+type CancelRecurringOrderRequest struct {
+	Order         Pubkey `json:"order"`
+	User          Pubkey `json:"user"`
+	RecurringType string `json:"recurringType"`
+}
+
+type CancelRecurringOrderResponse struct {
+	RequestID   string `json:"requestId"`
+	Transaction string `json:"transaction"`
+}
+
+func (c *Client) CancelRecurringOrder(ctx context.Context, body CancelRecurringOrderRequest) (*CancelRecurringOrderResponse, error) {
+	if err := requirePubkey("order", body.Order); err != nil {
+		return nil, err
+	}
+	if err := requirePubkey("user", body.User); err != nil {
+		return nil, err
+	}
+	request, err := NewPostRequest(c.Url("/recurring/v1/cancelOrder"), body)
+	if err != nil {
+		return nil, err
+	}
+	var response CancelRecurringOrderResponse
+	_, err = c.doCall(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
@@ -0,0 +1,66 @@
+package jupiter
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestCancelRecurringOrder(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/recurring/v1/cancelOrder" {
+			t.Errorf("expected path /recurring/v1/cancelOrder, got %s", r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var req CancelRecurringOrderRequest
+		json.Unmarshal(body, &req)
+		if req.Order != testOrder {
+			t.Errorf("expected Order %s, got %s", testOrder, req.Order)
+		}
+		if req.User != testUser {
+			t.Errorf("expected User %s, got %s", testUser, req.User)
+		}
+		if req.RecurringType != "time" {
+			t.Errorf("expected RecurringType time, got %s", req.RecurringType)
+		}
+
+		resp := CancelRecurringOrderResponse{
+			RequestID:   "cancel-req-1",
+			Transaction: "cancel-tx-1",
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+	client := newTestClient(server.URL)
+
+	result, err := client.CancelRecurringOrder(context.Background(), CancelRecurringOrderRequest{
+		Order:         testOrder,
+		User:          testUser,
+		RecurringType: "time",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Transaction != "cancel-tx-1" {
+		t.Errorf("expected transaction cancel-tx-1, got %s", result.Transaction)
+	}
+	if result.RequestID != "cancel-req-1" {
+		t.Errorf("expected requestId cancel-req-1, got %s", result.RequestID)
+	}
+}
+
+func TestCancelRecurringOrder_Error(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusBadRequest, "order not found"))
+	client := newTestClient(server.URL)
+
+	_, err := client.CancelRecurringOrder(context.Background(), CancelRecurringOrderRequest{Order: testOrder, User: testUser})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,91 @@
+package jupiter
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type RecurringTimeParams struct {
+	InAmount       Amount   `json:"inAmount"`
+	NumberOfOrders int      `json:"numberOfOrders"`
+	Interval       int64    `json:"interval"`
+	MinPrice       *float64 `json:"minPrice"`
+	MaxPrice       *float64 `json:"maxPrice"`
+	StartAt        *int64   `json:"startAt"`
+}
+
+// MarshalJSON sends InAmount as a JSON number, as the recurring API expects.
+func (p RecurringTimeParams) MarshalJSON() ([]byte, error) {
+	type params RecurringTimeParams
+	return json.Marshal(struct {
+		params
+		InAmount json.Number `json:"inAmount"`
+	}{params(p), p.InAmount.number()})
+}
+
+type RecurringPriceParams struct {
+	DepositAmount      Amount `json:"depositAmount"`
+	IncrementUsdcValue Amount `json:"incrementUsdcValue"`
+	Interval           int64  `json:"interval"`
+	StartAt            *int64 `json:"startAt"`
+}
+
+// MarshalJSON sends the amounts as JSON numbers, as the recurring API
+// expects.
+func (p RecurringPriceParams) MarshalJSON() ([]byte, error) {
+	type params RecurringPriceParams
+	return json.Marshal(struct {
+		params
+		DepositAmount      json.Number `json:"depositAmount"`
+		IncrementUsdcValue json.Number `json:"incrementUsdcValue"`
+	}{params(p), p.DepositAmount.number(), p.IncrementUsdcValue.number()})
+}
+
+type RecurringOrderParams struct {
+	Time  *RecurringTimeParams  `json:"time,omitempty"`
+	Price *RecurringPriceParams `json:"price,omitempty"`
+}
+
+type CreateRecurringOrderRequest struct {
+	User       Pubkey               `json:"user"`
+	InputMint  Pubkey               `json:"inputMint"`
+	OutputMint Pubkey               `json:"outputMint"`
+	Params     RecurringOrderParams `json:"params"`
+}
+
+type CreateRecurringOrderResponse struct {
+	RequestID   string `json:"requestId"`
+	Transaction string `json:"transaction"`
+}
+
+func (r CreateRecurringOrderRequest) validate() error {
+	for _, field := range []struct {
+		name string
+		key  Pubkey
+	}{
+		{"user", r.User},
+		{"inputMint", r.InputMint},
+		{"outputMint", r.OutputMint},
+	} {
+		if err := requirePubkey(field.name, field.key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) CreateRecurringOrder(ctx context.Context, body CreateRecurringOrderRequest) (*CreateRecurringOrderResponse, error) {
+	if err := body.validate(); err != nil {
+		return nil, err
+	}
+	request, err := NewPostRequest(c.Url("/recurring/v1/createOrder"), body)
+	if err != nil {
+		return nil, err
+	}
+	var response CreateRecurringOrderResponse
+	_, err = c.doCall(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
@@ -0,0 +1,134 @@
+package jupiter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestCreateRecurringOrder_Time(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/recurring/v1/createOrder" {
+			t.Errorf("expected path /recurring/v1/createOrder, got %s", r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var req CreateRecurringOrderRequest
+		json.Unmarshal(body, &req)
+		if req.User != testUser {
+			t.Errorf("expected User %s, got %s", testUser, req.User)
+		}
+		if req.Params.Time == nil {
+			t.Fatal("expected time params")
+		}
+		if req.Params.Price != nil {
+			t.Errorf("expected no price params, got %v", req.Params.Price)
+		}
+		if req.Params.Time.InAmount != NewAmount(104000000) {
+			t.Errorf("expected InAmount 104000000, got %s", req.Params.Time.InAmount)
+		}
+		if !bytes.Contains(body, []byte(`"inAmount":104000000`)) {
+			t.Errorf("expected inAmount as a JSON number, got %s", body)
+		}
+		if req.Params.Time.NumberOfOrders != 2 {
+			t.Errorf("expected NumberOfOrders 2, got %d", req.Params.Time.NumberOfOrders)
+		}
+		if req.Params.Time.Interval != 86400 {
+			t.Errorf("expected Interval 86400, got %d", req.Params.Time.Interval)
+		}
+
+		resp := CreateRecurringOrderResponse{
+			RequestID:   "req-123",
+			Transaction: "tx-456",
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+	client := newTestClient(server.URL)
+
+	result, err := client.CreateRecurringOrder(context.Background(), CreateRecurringOrderRequest{
+		User:       testUser,
+		InputMint:  USDC,
+		OutputMint: WrappedSOL,
+		Params: RecurringOrderParams{
+			Time: &RecurringTimeParams{
+				InAmount:       NewAmount(104000000),
+				NumberOfOrders: 2,
+				Interval:       86400,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.RequestID != "req-123" {
+		t.Errorf("expected requestId req-123, got %s", result.RequestID)
+	}
+	if result.Transaction != "tx-456" {
+		t.Errorf("expected transaction tx-456, got %s", result.Transaction)
+	}
+}
+
+func TestCreateRecurringOrder_Price(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var req CreateRecurringOrderRequest
+		json.Unmarshal(body, &req)
+		if req.Params.Price == nil {
+			t.Fatal("expected price params")
+		}
+		if req.Params.Time != nil {
+			t.Errorf("expected no time params, got %v", req.Params.Time)
+		}
+		if req.Params.Price.DepositAmount != NewAmount(110000000) {
+			t.Errorf("expected DepositAmount 110000000, got %s", req.Params.Price.DepositAmount)
+		}
+		if req.Params.Price.IncrementUsdcValue != NewAmount(10000000) {
+			t.Errorf("expected IncrementUsdcValue 10000000, got %s", req.Params.Price.IncrementUsdcValue)
+		}
+		if !bytes.Contains(body, []byte(`"depositAmount":110000000`)) || !bytes.Contains(body, []byte(`"incrementUsdcValue":10000000`)) {
+			t.Errorf("expected amounts as JSON numbers, got %s", body)
+		}
+		if req.Params.Price.StartAt == nil || *req.Params.Price.StartAt != 1700000000 {
+			t.Errorf("expected StartAt 1700000000, got %v", req.Params.Price.StartAt)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(CreateRecurringOrderResponse{})
+	})
+	client := newTestClient(server.URL)
+
+	startAt := int64(1700000000)
+	_, err := client.CreateRecurringOrder(context.Background(), CreateRecurringOrderRequest{
+		User:       testUser,
+		InputMint:  USDC,
+		OutputMint: WrappedSOL,
+		Params: RecurringOrderParams{
+			Price: &RecurringPriceParams{
+				DepositAmount:      NewAmount(110000000),
+				IncrementUsdcValue: NewAmount(10000000),
+				Interval:           86400,
+				StartAt:            &startAt,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCreateRecurringOrder_Error(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusBadRequest, "invalid order"))
+	client := newTestClient(server.URL)
+
+	_, err := client.CreateRecurringOrder(context.Background(), CreateRecurringOrderRequest{User: testUser, InputMint: USDC, OutputMint: WrappedSOL})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
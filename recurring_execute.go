@@ -0,0 +1,25 @@
+package jupiter
+
+import (
+	"context"
+)
+
+type RecurringExecuteResponse struct {
+	Signature string `json:"signature,omitempty"`
+	Status    string `json:"status"`
+	Order     string `json:"order,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+func (c *Client) ExecuteRecurring(ctx context.Context, body ExecuteRequest) (*RecurringExecuteResponse, error) {
+	request, err := NewPostRequest(c.Url("/recurring/v1/execute"), body)
+	if err != nil {
+		return nil, err
+	}
+	var response RecurringExecuteResponse
+	_, err = c.doCall(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
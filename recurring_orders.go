@@ -0,0 +1,127 @@
+package jupiter
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type GetRecurringOrdersParams struct {
+	User            Pubkey
+	RecurringType   string
+	OrderStatus     string
+	Page            int
+	IncludeFailedTx bool
+	Mint            Pubkey
+}
+
+type RecurringTrade struct {
+	OrderKey        Pubkey  `json:"orderKey"`
+	Keeper          Pubkey  `json:"keeper"`
+	InputMint       Pubkey  `json:"inputMint"`
+	OutputMint      Pubkey  `json:"outputMint"`
+	InputAmount     Decimal `json:"inputAmount"`
+	OutputAmount    Decimal `json:"outputAmount"`
+	RawInputAmount  Amount  `json:"rawInputAmount"`
+	RawOutputAmount Amount  `json:"rawOutputAmount"`
+	FeeMint         Pubkey  `json:"feeMint"`
+	FeeAmount       Decimal `json:"feeAmount"`
+	RawFeeAmount    Amount  `json:"rawFeeAmount"`
+	TxID            string  `json:"txId"`
+	ConfirmedAt     string  `json:"confirmedAt"`
+	Action          string  `json:"action"`
+}
+
+type TimeRecurringOrder struct {
+	UserPubkey          Pubkey           `json:"userPubkey"`
+	OrderKey            Pubkey           `json:"orderKey"`
+	InputMint           Pubkey           `json:"inputMint"`
+	OutputMint          Pubkey           `json:"outputMint"`
+	InDeposited         Decimal          `json:"inDeposited"`
+	InWithdrawn         Decimal          `json:"inWithdrawn"`
+	RawInDeposited      Amount           `json:"rawInDeposited"`
+	RawInWithdrawn      Amount           `json:"rawInWithdrawn"`
+	CycleFrequency      string           `json:"cycleFrequency"`
+	OutWithdrawn        Decimal          `json:"outWithdrawn"`
+	InAmountPerCycle    Decimal          `json:"inAmountPerCycle"`
+	MinOutAmount        Decimal          `json:"minOutAmount"`
+	MaxOutAmount        Decimal          `json:"maxOutAmount"`
+	InUsed              Decimal          `json:"inUsed"`
+	OutReceived         Decimal          `json:"outReceived"`
+	RawOutWithdrawn     Amount           `json:"rawOutWithdrawn"`
+	RawInAmountPerCycle Amount           `json:"rawInAmountPerCycle"`
+	RawMinOutAmount     Amount           `json:"rawMinOutAmount"`
+	RawMaxOutAmount     Amount           `json:"rawMaxOutAmount"`
+	RawInUsed           Amount           `json:"rawInUsed"`
+	RawOutReceived      Amount           `json:"rawOutReceived"`
+	OpenTx              string           `json:"openTx"`
+	CloseTx             string           `json:"closeTx"`
+	UserClosed          bool             `json:"userClosed"`
+	CreatedAt           string           `json:"createdAt"`
+	UpdatedAt           string           `json:"updatedAt"`
+	Trades              []RecurringTrade `json:"trades"`
+}
+
+type PriceRecurringOrder struct {
+	UserPubkey              Pubkey           `json:"userPubkey"`
+	OrderKey                Pubkey           `json:"orderKey"`
+	InputMint               Pubkey           `json:"inputMint"`
+	OutputMint              Pubkey           `json:"outputMint"`
+	InDeposited             Decimal          `json:"inDeposited"`
+	InWithdrawn             Decimal          `json:"inWithdrawn"`
+	RawInDeposited          Amount           `json:"rawInDeposited"`
+	RawInWithdrawn          Amount           `json:"rawInWithdrawn"`
+	OutWithdrawn            Decimal          `json:"outWithdrawn"`
+	RawOutWithdrawn         Amount           `json:"rawOutWithdrawn"`
+	InUsed                  Decimal          `json:"inUsed"`
+	OutReceived             Decimal          `json:"outReceived"`
+	RawInUsed               Amount           `json:"rawInUsed"`
+	RawOutReceived          Amount           `json:"rawOutReceived"`
+	EstimatedUsdcValueSpent Decimal          `json:"estimatedUsdcValueSpent"`
+	IncrementUsdcValue      Decimal          `json:"incrementUsdcValue"`
+	OrderInterval           string           `json:"orderInterval"`
+	StartAt                 string           `json:"startAt"`
+	Status                  string           `json:"status"`
+	OpenTx                  string           `json:"openTx"`
+	CloseTx                 string           `json:"closeTx"`
+	ClosedBy                string           `json:"closedBy"`
+	CreatedAt               string           `json:"createdAt"`
+	UpdatedAt               string           `json:"updatedAt"`
+	Trades                  []RecurringTrade `json:"trades"`
+}
+
+type GetRecurringOrdersResponse struct {
+	User        Pubkey                `json:"user"`
+	OrderStatus string                `json:"orderStatus"`
+	Time        []TimeRecurringOrder  `json:"time,omitempty"`
+	Price       []PriceRecurringOrder `json:"price,omitempty"`
+	TotalPages  int                   `json:"totalPages"`
+	Page        int                   `json:"page"`
+}
+
+func (c *Client) GetRecurringOrders(ctx context.Context, params GetRecurringOrdersParams) (*GetRecurringOrdersResponse, error) {
+	if err := requirePubkey("user", params.User); err != nil {
+		return nil, err
+	}
+	queryParams := url.Values{}
+
+	queryParams.Set("user", params.User.String())
+	queryParams.Set("recurringType", params.RecurringType)
+	queryParams.Set("orderStatus", params.OrderStatus)
+	queryParams.Set("includeFailedTx", fmt.Sprintf("%t", params.IncludeFailedTx))
+
+	if params.Page > 0 {
+		queryParams.Set("page", fmt.Sprintf("%d", params.Page))
+	}
+	if !params.Mint.IsZero() {
+		queryParams.Set("mint", params.Mint.String())
+	}
+
+	request := NewRequest(c.Url("/recurring/v1/getRecurringOrders"), queryParams)
+	var response GetRecurringOrdersResponse
+	_, err := c.doCall(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
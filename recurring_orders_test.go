@@ -0,0 +1,120 @@
+package jupiter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestGetRecurringOrders(t *testing.T) {
+	resp := GetRecurringOrdersResponse{
+		User:        testUser,
+		OrderStatus: "active",
+		Time: []TimeRecurringOrder{
+			{
+				UserPubkey:     testUser,
+				OrderKey:       testOrder,
+				InputMint:      USDC,
+				OutputMint:     WrappedSOL,
+				CycleFrequency: "86400",
+				Trades: []RecurringTrade{
+					{OrderKey: testOrder, InputAmount: testDecimal("52"), OutputAmount: testDecimal("0.35"), Action: "Fill"},
+				},
+			},
+		},
+		TotalPages: 1,
+		Page:       1,
+	}
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/recurring/v1/getRecurringOrders" {
+			t.Errorf("expected path /recurring/v1/getRecurringOrders, got %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("user") != testUser.String() {
+			t.Errorf("expected user=%s, got %s", testUser, q.Get("user"))
+		}
+		if q.Get("recurringType") != "time" {
+			t.Errorf("expected recurringType=time, got %s", q.Get("recurringType"))
+		}
+		if q.Get("orderStatus") != "active" {
+			t.Errorf("expected orderStatus=active, got %s", q.Get("orderStatus"))
+		}
+		if q.Get("includeFailedTx") != "false" {
+			t.Errorf("expected includeFailedTx=false, got %s", q.Get("includeFailedTx"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+	client := newTestClient(server.URL)
+
+	result, err := client.GetRecurringOrders(context.Background(), GetRecurringOrdersParams{
+		User:          testUser,
+		RecurringType: "time",
+		OrderStatus:   "active",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Time) != 1 {
+		t.Fatalf("expected 1 time order, got %d", len(result.Time))
+	}
+	if len(result.Time[0].Trades) != 1 {
+		t.Fatalf("expected 1 trade, got %d", len(result.Time[0].Trades))
+	}
+	if result.Time[0].Trades[0].Action != "Fill" {
+		t.Errorf("expected trade action Fill, got %s", result.Time[0].Trades[0].Action)
+	}
+	if len(result.Price) != 0 {
+		t.Errorf("expected no price orders, got %d", len(result.Price))
+	}
+}
+
+func TestGetRecurringOrders_WithOptionalParams(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("page") != "3" {
+			t.Errorf("expected page=3, got %s", q.Get("page"))
+		}
+		if q.Get("includeFailedTx") != "true" {
+			t.Errorf("expected includeFailedTx=true, got %s", q.Get("includeFailedTx"))
+		}
+		if q.Get("mint") != WrappedSOL.String() {
+			t.Errorf("expected mint=%s, got %s", WrappedSOL, q.Get("mint"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(GetRecurringOrdersResponse{
+			Price: []PriceRecurringOrder{{OrderKey: testOrder2, Status: "active"}},
+		})
+	})
+	client := newTestClient(server.URL)
+
+	result, err := client.GetRecurringOrders(context.Background(), GetRecurringOrdersParams{
+		User:            testUser,
+		RecurringType:   "price",
+		OrderStatus:     "history",
+		Page:            3,
+		IncludeFailedTx: true,
+		Mint:            WrappedSOL,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Price) != 1 {
+		t.Fatalf("expected 1 price order, got %d", len(result.Price))
+	}
+}
+
+func TestGetRecurringOrders_Error(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusBadRequest, "missing user"))
+	client := newTestClient(server.URL)
+
+	_, err := client.GetRecurringOrders(context.Background(), GetRecurringOrdersParams{User: testUser})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,90 @@
+package jupiter
+
+import (
+	"context"
+	"encoding/json"
+)
+
+type PriceDepositRequest struct {
+	Order  Pubkey `json:"order"`
+	User   Pubkey `json:"user"`
+	Amount Amount `json:"amount"`
+}
+
+// MarshalJSON sends Amount as a JSON number, as the recurring API expects.
+func (r PriceDepositRequest) MarshalJSON() ([]byte, error) {
+	type request PriceDepositRequest
+	return json.Marshal(struct {
+		request
+		Amount json.Number `json:"amount"`
+	}{request(r), r.Amount.number()})
+}
+
+type PriceDepositResponse struct {
+	RequestID   string `json:"requestId"`
+	Transaction string `json:"transaction"`
+}
+
+func (c *Client) PriceDeposit(ctx context.Context, body PriceDepositRequest) (*PriceDepositResponse, error) {
+	if err := requirePubkey("order", body.Order); err != nil {
+		return nil, err
+	}
+	if err := requirePubkey("user", body.User); err != nil {
+		return nil, err
+	}
+	request, err := NewPostRequest(c.Url("/recurring/v1/priceDeposit"), body)
+	if err != nil {
+		return nil, err
+	}
+	var response PriceDepositResponse
+	_, err = c.doCall(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// PriceWithdrawRequest withdraws Amount, or everything when Amount is zero.
+type PriceWithdrawRequest struct {
+	Order         Pubkey `json:"order"`
+	User          Pubkey `json:"user"`
+	InputOrOutput string `json:"inputOrOutput"`
+	Amount        Amount `json:"amount,omitzero"`
+}
+
+// MarshalJSON sends Amount as a JSON number, as the recurring API expects.
+func (r PriceWithdrawRequest) MarshalJSON() ([]byte, error) {
+	type request PriceWithdrawRequest
+	var amount json.Number
+	if !r.Amount.IsZero() {
+		amount = r.Amount.number()
+	}
+	return json.Marshal(struct {
+		request
+		Amount json.Number `json:"amount,omitempty"`
+	}{request(r), amount})
+}
+
+type PriceWithdrawResponse struct {
+	RequestID   string `json:"requestId"`
+	Transaction string `json:"transaction"`
+}
+
+func (c *Client) PriceWithdraw(ctx context.Context, body PriceWithdrawRequest) (*PriceWithdrawResponse, error) {
+	if err := requirePubkey("order", body.Order); err != nil {
+		return nil, err
+	}
+	if err := requirePubkey("user", body.User); err != nil {
+		return nil, err
+	}
+	request, err := NewPostRequest(c.Url("/recurring/v1/priceWithdraw"), body)
+	if err != nil {
+		return nil, err
+	}
+	var response PriceWithdrawResponse
+	_, err = c.doCall(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
@@ -0,0 +1,125 @@
+package jupiter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestPriceDeposit(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/recurring/v1/priceDeposit" {
+			t.Errorf("expected path /recurring/v1/priceDeposit, got %s", r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var req PriceDepositRequest
+		json.Unmarshal(body, &req)
+		if req.Order != testOrder {
+			t.Errorf("expected Order %s, got %s", testOrder, req.Order)
+		}
+		if req.Amount != NewAmount(1000000) {
+			t.Errorf("expected Amount 1000000, got %s", req.Amount)
+		}
+		if !bytes.Contains(body, []byte(`"amount":1000000`)) {
+			t.Errorf("expected amount as a JSON number, got %s", body)
+		}
+
+		resp := PriceDepositResponse{
+			RequestID:   "deposit-req-1",
+			Transaction: "deposit-tx-1",
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+	client := newTestClient(server.URL)
+
+	result, err := client.PriceDeposit(context.Background(), PriceDepositRequest{
+		Order:  testOrder,
+		User:   testUser,
+		Amount: NewAmount(1000000),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Transaction != "deposit-tx-1" {
+		t.Errorf("expected transaction deposit-tx-1, got %s", result.Transaction)
+	}
+}
+
+func TestPriceDeposit_Error(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusBadRequest, "invalid amount"))
+	client := newTestClient(server.URL)
+
+	_, err := client.PriceDeposit(context.Background(), PriceDepositRequest{Order: testOrder, User: testUser})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestPriceWithdraw(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/recurring/v1/priceWithdraw" {
+			t.Errorf("expected path /recurring/v1/priceWithdraw, got %s", r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var req map[string]any
+		json.Unmarshal(body, &req)
+		if req["inputOrOutput"] != "In" {
+			t.Errorf("expected inputOrOutput In, got %v", req["inputOrOutput"])
+		}
+		if _, ok := req["amount"]; ok {
+			t.Errorf("expected amount to be omitted, got %v", req["amount"])
+		}
+
+		resp := PriceWithdrawResponse{
+			RequestID:   "withdraw-req-1",
+			Transaction: "withdraw-tx-1",
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+	client := newTestClient(server.URL)
+
+	result, err := client.PriceWithdraw(context.Background(), PriceWithdrawRequest{
+		Order:         testOrder,
+		User:          testUser,
+		InputOrOutput: "In",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.RequestID != "withdraw-req-1" {
+		t.Errorf("expected requestId withdraw-req-1, got %s", result.RequestID)
+	}
+}
+
+func TestPriceWithdraw_Error(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusBadRequest, "invalid order"))
+	client := newTestClient(server.URL)
+
+	_, err := client.PriceWithdraw(context.Background(), PriceWithdrawRequest{Order: testOrder, User: testUser})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestPriceWithdrawRequest_JSON(t *testing.T) {
+	data, err := json.Marshal(PriceWithdrawRequest{Order: testOrder, User: testUser, InputOrOutput: "Out", Amount: NewAmount(5000)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Contains(data, []byte(`"amount":5000`)) {
+		t.Errorf("expected amount as a JSON number, got %s", data)
+	}
+}
@@ -0,0 +1,199 @@
+package jupiter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+const redacted = "REDACTED"
+
+// Redactor removes secrets from URLs, headers and request bodies before
+// they end up in errors or logs. Query parameters and top-level JSON body
+// fields are matched case-insensitively; API key headers are always
+// masked.
+type Redactor struct {
+	QueryParams []string
+	BodyFields  []string
+}
+
+// DefaultRedactor hides wallet addresses passed as taker or user, API keys
+// passed in the query string and signed transactions.
+func DefaultRedactor() *Redactor {
+	return &Redactor{
+		QueryParams: []string{"taker", "user", "api-key", "apiKey", "api_key"},
+		BodyFields:  []string{"signedTransaction"},
+	}
+}
+
+func matchFold(names []string, name string) bool {
+	return slices.ContainsFunc(names, func(n string) bool {
+		return strings.EqualFold(n, name)
+	})
+}
+
+// Query returns a copy of query with the configured parameters redacted.
+func (r *Redactor) Query(query url.Values) url.Values {
+	if r == nil {
+		return query
+	}
+	redactedQuery := make(url.Values, len(query))
+	for name, values := range query {
+		if matchFold(r.QueryParams, name) {
+			values = []string{redacted}
+		}
+		redactedQuery[name] = values
+	}
+	return redactedQuery
+}
+
+// URL returns rawURL with the configured query parameters redacted.
+func (r *Redactor) URL(rawURL string) string {
+	if r == nil {
+		return rawURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || u.RawQuery == "" {
+		return rawURL
+	}
+	query := u.Query()
+	for name := range query {
+		if matchFold(r.QueryParams, name) {
+			u.RawQuery = r.Query(query).Encode()
+			return u.String()
+		}
+	}
+	return rawURL
+}
+
+// Header returns a copy of header with the API key masked.
+func (r *Redactor) Header(header http.Header) http.Header {
+	header = header.Clone()
+	if key := header.Get(ApiKeyHeader); key != "" {
+		header.Set(ApiKeyHeader, maskKey(key))
+	}
+	return header
+}
+
+// Body returns body with the configured top-level JSON fields redacted.
+// Bodies that are not JSON objects are returned unchanged.
+func (r *Redactor) Body(body []byte) []byte {
+	if r == nil || len(r.BodyFields) == 0 {
+		return body
+	}
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(body, &fields) != nil {
+		return body
+	}
+	changed := false
+	for name := range fields {
+		if matchFold(r.BodyFields, name) {
+			fields[name] = json.RawMessage(`"` + redacted + `"`)
+			changed = true
+		}
+	}
+	if !changed {
+		return body
+	}
+	redactedBody, err := json.Marshal(fields)
+	if err != nil {
+		return body
+	}
+	return redactedBody
+}
+
+// redactError redacts the URL of a *url.Error inside err, as returned by
+// http.Client.Do and http.NewRequest.
+func (r *Redactor) redactError(err error) error {
+	var uErr *url.Error
+	if r != nil && errors.As(err, &uErr) {
+		uErr.URL = r.URL(uErr.URL)
+	}
+	return err
+}
+
+func (c *Client) String() string {
+	return fmt.Sprintf("Client{ApiUrl: %s, ApiKey: %s}", c.ApiUrl, maskSecret(c.ApiKey))
+}
+
+func (c *Client) GoString() string {
+	return fmt.Sprintf("&jupiter.Client{ApiUrl:%q, ApiKey:%q}", c.ApiUrl, maskSecret(c.ApiKey))
+}
+
+func maskSecret(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	return maskKey(secret)
+}
+
+// redactor returns the Redactor of the client that sent r, or
+// DefaultRedactor for a request that was not sent yet.
+func (r *Request) redactor() *Redactor {
+	if r.client != nil {
+		return r.client.Redactor
+	}
+	return DefaultRedactor()
+}
+
+// String describes the request with the client's Redactor applied, or
+// DefaultRedactor before the request was sent.
+func (r *Request) String() string {
+	redactor := r.redactor()
+	endpoint := r.Endpoint
+	if len(r.QueryParams) > 0 {
+		endpoint += "?" + redactor.Query(r.QueryParams).Encode()
+	}
+	if r.Body == nil {
+		return fmt.Sprintf("%s %s", r.Method, endpoint)
+	}
+	return fmt.Sprintf("%s %s %s", r.Method, endpoint, redactor.Body(r.Body))
+}
+
+func (r *Request) GoString() string {
+	redactor := r.redactor()
+	return fmt.Sprintf("&jupiter.Request{Endpoint:%q, Method:%q, QueryParams:%q, Body:%q, Idempotent:%t}",
+		r.Endpoint, r.Method, redactor.Query(r.QueryParams).Encode(), redactor.Body(r.Body), r.Idempotent)
+}
+
+// WithRedactor sets the redactor used for errors. A nil redactor disables
+// redaction.
+func WithRedactor(redactor *Redactor) Option {
+	return func(c *Client) {
+		c.Redactor = redactor
+	}
+}
+
+// LoggingMiddleware logs every attempt at debug level, or at warn level when
+// it fails, with its URL and headers passed through redactor.
+func LoggingMiddleware(logger *slog.Logger, redactor *Redactor) Middleware {
+	return func(next Handler) Handler {
+		return func(call *Call) (*http.Response, error) {
+			start := time.Now()
+			httpResponse, err := next(call)
+
+			attrs := []slog.Attr{
+				slog.String("method", call.Request.Method),
+				slog.String("url", redactor.URL(call.Request.URL.String())),
+				slog.Any("headers", redactor.Header(call.Request.Header)),
+				slog.Duration("duration", time.Since(start)),
+			}
+			if httpResponse != nil {
+				attrs = append(attrs, slog.Int("status", httpResponse.StatusCode))
+			}
+			level := slog.LevelDebug
+			if err != nil {
+				level = slog.LevelWarn
+				attrs = append(attrs, slog.String("error", err.Error()))
+			}
+			logger.LogAttrs(call.Request.Context(), level, "jupiter api call", attrs...)
+			return httpResponse, err
+		}
+	}
+}
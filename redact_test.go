@@ -0,0 +1,161 @@
+package jupiter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestRedactor_URL(t *testing.T) {
+	redactor := DefaultRedactor()
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"https://api.jup.ag/ultra/v1/order?amount=1&taker=Wallet111", "https://api.jup.ag/ultra/v1/order?amount=1&taker=REDACTED"},
+		{"https://api.jup.ag/trigger/v1/getTriggerOrders?User=Wallet111", "https://api.jup.ag/trigger/v1/getTriggerOrders?User=REDACTED"},
+		{"https://api.jup.ag/price/v3?ids=SOL", "https://api.jup.ag/price/v3?ids=SOL"},
+		{"https://api.jup.ag/price/v3", "https://api.jup.ag/price/v3"},
+	}
+	for _, tt := range tests {
+		if got := redactor.URL(tt.in); got != tt.want {
+			t.Errorf("URL(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+
+	var nilRedactor *Redactor
+	if got := nilRedactor.URL(tests[0].in); got != tests[0].in {
+		t.Errorf("expected nil redactor to keep URL, got %q", got)
+	}
+}
+
+func TestRedactor_Body(t *testing.T) {
+	redactor := DefaultRedactor()
+	got := redactor.Body([]byte(`{"requestId":"req-1","signedTransaction":"AQAB..."}`))
+	if strings.Contains(string(got), "AQAB") || !strings.Contains(string(got), "req-1") {
+		t.Errorf("expected signed transaction redacted, got %s", got)
+	}
+	if got := redactor.Body([]byte(`not json`)); string(got) != "not json" {
+		t.Errorf("expected non-JSON body unchanged, got %s", got)
+	}
+}
+
+func TestAPIError_URLRedacted(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusBadRequest, `{"error":"bad"}`))
+	client := newTestClient(server.URL)
+
+	_, err := client.GetUltraOrder(context.Background(), UltraOrderParams{InputMint: WrappedSOL, OutputMint: USDC, Amount: NewAmount(1), Taker: testUser})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	if strings.Contains(apiErr.URL, testUser.String()) || strings.Contains(err.Error(), testUser.String()) {
+		t.Errorf("expected taker redacted, got %s", err)
+	}
+	if !strings.Contains(apiErr.URL, "taker=REDACTED") {
+		t.Errorf("expected redacted taker in URL, got %s", apiErr.URL)
+	}
+}
+
+func TestTransportError_URLRedacted(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {})
+	server.Close()
+	client := newTestClient(server.URL)
+
+	req := NewRequest(client.Url("/ultra/v1/order"), url.Values{"taker": {"SecretWallet111"}})
+	var response map[string]any
+	_, err := client.doCall(context.Background(), req, &response)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if strings.Contains(err.Error(), "SecretWallet111") {
+		t.Errorf("expected taker redacted, got %s", err)
+	}
+}
+
+func TestWithRedactor_Configurable(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusBadRequest, ""))
+	client := newTestClient(server.URL)
+	WithRedactor(&Redactor{QueryParams: []string{"inputMint"}})(client)
+
+	_, err := client.GetUltraOrder(context.Background(), UltraOrderParams{InputMint: WrappedSOL, OutputMint: USDC, Amount: NewAmount(1), Taker: testUser})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), "inputMint=REDACTED") || !strings.Contains(err.Error(), testUser.String()) {
+		t.Errorf("expected only inputMint redacted, got %s", err)
+	}
+}
+
+func TestClient_StringHidesKey(t *testing.T) {
+	client := NewClient(DefaultURL, "super-secret-key-1234")
+	for _, s := range []string{client.String(), client.GoString(), fmt.Sprintf("%v", client), fmt.Sprintf("%#v", client)} {
+		if strings.Contains(s, "super-secret") {
+			t.Errorf("expected api key masked, got %s", s)
+		}
+		if !strings.Contains(s, "****1234") {
+			t.Errorf("expected masked key suffix, got %s", s)
+		}
+	}
+}
+
+func TestRequest_StringRedacts(t *testing.T) {
+	req, _ := NewPostRequest("https://api.jup.ag/ultra/v1/execute", ExecuteRequest{SignedTransaction: "AQAB-secret", RequestID: "req-1"})
+	get := NewRequest("https://api.jup.ag/ultra/v1/order", url.Values{"taker": {"Wallet111"}})
+
+	for _, s := range []string{req.String(), req.GoString(), get.String(), get.GoString()} {
+		if strings.Contains(s, "AQAB-secret") || strings.Contains(s, "Wallet111") {
+			t.Errorf("expected secrets redacted, got %s", s)
+		}
+	}
+}
+
+func TestRequest_StringUsesClientRedactor(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{}`))
+	})
+	client := newTestClient(server.URL)
+	WithRedactor(&Redactor{QueryParams: []string{"inputMint"}})(client)
+
+	req := NewRequest(client.Url("/ultra/v1/order"), url.Values{"inputMint": {"SecretMint111"}, "taker": {"Wallet111"}})
+	var response map[string]any
+	if _, err := client.doCall(context.Background(), req, &response); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, s := range []string{req.String(), req.GoString()} {
+		if strings.Contains(s, "SecretMint111") || !strings.Contains(s, "Wallet111") {
+			t.Errorf("expected only inputMint redacted, got %s", s)
+		}
+	}
+
+	WithRedactor(nil)(client)
+	if s := req.String(); !strings.Contains(s, "SecretMint111") {
+		t.Errorf("expected no redaction with a nil redactor, got %s", s)
+	}
+}
+
+func TestLoggingMiddleware_Redacts(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusBadRequest, ""))
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	client := newTestClient(server.URL)
+	client.Use(LoggingMiddleware(logger, DefaultRedactor()))
+
+	client.GetUltraOrder(context.Background(), UltraOrderParams{InputMint: WrappedSOL, OutputMint: USDC, Amount: NewAmount(1), Taker: testUser})
+
+	out := buf.String()
+	if !strings.Contains(out, "jupiter api call") || !strings.Contains(out, "status=400") {
+		t.Fatalf("expected call to be logged, got %s", out)
+	}
+	if strings.Contains(out, testUser.String()) || strings.Contains(out, "test-api-key") {
+		t.Errorf("expected secrets redacted from log, got %s", out)
+	}
+}
@@ -0,0 +1,89 @@
+package jupiter
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+)
+
+// DefaultMaxResponseSize caps decoded response bodies unless
+// WithMaxResponseSize says otherwise.
+const DefaultMaxResponseSize = 64 << 20
+
+const (
+	EncodingGzip   = "gzip"
+	EncodingBrotli = "br"
+)
+
+// limitedReader yields at most limit bytes and then fails with
+// ErrResponseTooLarge if the body has more. A limit of zero or less
+// disables the check.
+type limitedReader struct {
+	r     io.Reader
+	limit int64
+	read  int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.limit <= 0 {
+		return l.r.Read(p)
+	}
+	if l.read > l.limit {
+		return 0, ErrResponseTooLarge
+	}
+	if remaining := l.limit - l.read + 1; int64(len(p)) > remaining {
+		p = p[:remaining]
+	}
+	n, err := l.r.Read(p)
+	l.read += int64(n)
+	if l.read > l.limit {
+		return n - 1, ErrResponseTooLarge
+	}
+	return n, err
+}
+
+// responseBody returns the decompressed, size limited body of httpResponse.
+// Compressed bodies only need handling here when Accept-Encoding was set
+// explicitly; otherwise net/http already decompressed gzip.
+func (c *Client) responseBody(httpResponse *http.Response) (io.Reader, error) {
+	var body io.Reader = httpResponse.Body
+	switch encoding := strings.ToLower(strings.TrimSpace(httpResponse.Header.Get("Content-Encoding"))); encoding {
+	case "", "identity":
+	case EncodingGzip:
+		gz, err := gzip.NewReader(body)
+		if err != nil {
+			return nil, err
+		}
+		body = gz
+	case EncodingBrotli:
+		body = brotli.NewReader(body)
+	default:
+		return nil, fmt.Errorf("unsupported content encoding %q", encoding)
+	}
+	return &limitedReader{r: body, limit: c.maxResponseSize}, nil
+}
+
+// WithMaxResponseSize caps the decoded size of response bodies. Larger
+// successful responses fail with a *ResponseTooLargeError; error bodies are
+// truncated. Zero or less disables the cap.
+func WithMaxResponseSize(size int64) Option {
+	return func(c *Client) {
+		c.maxResponseSize = size
+	}
+}
+
+// WithCompression asks the API for compressed responses using the given
+// encodings, in order of preference (EncodingGzip, EncodingBrotli). Without
+// arguments it offers both, gzip first.
+func WithCompression(encodings ...string) Option {
+	if len(encodings) == 0 {
+		encodings = []string{EncodingGzip, EncodingBrotli}
+	}
+	return func(c *Client) {
+		c.acceptEncoding = strings.Join(encodings, ", ")
+	}
+}
@@ -0,0 +1,138 @@
+package jupiter
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+)
+
+func TestDoCall_ResponseTooLarge(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":"` + strings.Repeat("x", 1024) + `"}`))
+	})
+	client := newTestClient(server.URL)
+	WithMaxResponseSize(512)(client)
+
+	req := NewRequest(client.Url("/tokens/v2/toptraded/24h"), nil)
+	var response map[string]string
+	_, err := client.doCall(context.Background(), req, &response)
+	if !errors.Is(err, ErrResponseTooLarge) {
+		t.Fatalf("expected ErrResponseTooLarge, got %v", err)
+	}
+	var tooLarge *ResponseTooLargeError
+	if !errors.As(err, &tooLarge) || tooLarge.Limit != 512 {
+		t.Errorf("expected ResponseTooLargeError with limit 512, got %v", err)
+	}
+}
+
+func TestDoCall_ResponseWithinLimit(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"data":"ok"}`))
+	})
+	client := newTestClient(server.URL)
+	WithMaxResponseSize(int64(len(`{"data":"ok"}`)))(client)
+
+	req := NewRequest(client.Url("/test"), nil)
+	var response map[string]string
+	if _, err := client.doCall(context.Background(), req, &response); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if response["data"] != "ok" {
+		t.Errorf("expected data ok, got %v", response)
+	}
+}
+
+func TestDoCall_ErrorBodyTruncated(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusBadRequest, strings.Repeat("e", 100)))
+	client := newTestClient(server.URL)
+	WithMaxResponseSize(10)(client)
+
+	req := NewRequest(client.Url("/test"), nil)
+	var response map[string]string
+	_, err := client.doCall(context.Background(), req, &response)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	if len(apiErr.RawBody) > 11 {
+		t.Errorf("expected truncated body, got %d bytes", len(apiErr.RawBody))
+	}
+}
+
+func TestDoCall_Compression(t *testing.T) {
+	payload := []byte(`{"data":"compressed"}`)
+	tests := []struct {
+		encoding string
+		compress func([]byte) []byte
+	}{
+		{EncodingGzip, func(b []byte) []byte {
+			var buf bytes.Buffer
+			w := gzip.NewWriter(&buf)
+			w.Write(b)
+			w.Close()
+			return buf.Bytes()
+		}},
+		{EncodingBrotli, func(b []byte) []byte {
+			var buf bytes.Buffer
+			w := brotli.NewWriter(&buf)
+			w.Write(b)
+			w.Close()
+			return buf.Bytes()
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.encoding, func(t *testing.T) {
+			server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("Accept-Encoding"); got != "br, gzip" {
+					t.Errorf("expected Accept-Encoding br, gzip, got %q", got)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("Content-Encoding", tt.encoding)
+				w.Write(tt.compress(payload))
+			})
+			client := newTestClient(server.URL)
+			WithCompression(EncodingBrotli, EncodingGzip)(client)
+
+			req := NewRequest(client.Url("/test"), nil)
+			var response map[string]string
+			if _, err := client.doCall(context.Background(), req, &response); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if response["data"] != "compressed" {
+				t.Errorf("expected data compressed, got %v", response)
+			}
+		})
+	}
+}
+
+func TestWithCompression_Default(t *testing.T) {
+	client := newTestClient("https://unused.invalid")
+	WithCompression()(client)
+	if client.acceptEncoding != "gzip, br" {
+		t.Errorf("expected Accept-Encoding gzip, br, got %q", client.acceptEncoding)
+	}
+}
+
+func TestDoCall_UnsupportedEncoding(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "zstd")
+		w.Write([]byte("??"))
+	})
+	client := newTestClient(server.URL)
+
+	req := NewRequest(client.Url("/test"), nil)
+	var response map[string]string
+	_, err := client.doCall(context.Background(), req, &response)
+	if err == nil || !strings.Contains(err.Error(), "unsupported content encoding") {
+		t.Fatalf("expected unsupported encoding error, got %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+package jupiter
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how doCall retries failed attempts. Requests that are
+// not idempotent (POST by default) are only retried when the failure is
+// known to have happened before the request was written to the connection.
+type RetryPolicy struct {
+	MaxAttempts          int
+	BaseBackoff          time.Duration
+	MaxBackoff           time.Duration
+	Jitter               float64
+	RetryableStatusCodes []int
+	RespectRetryAfter    bool
+}
+
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		BaseBackoff: 250 * time.Millisecond,
+		MaxBackoff:  5 * time.Second,
+		Jitter:      0.2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RespectRetryAfter: true,
+	}
+}
+
+// transportError is returned when the HTTP round trip itself failed.
+// written reports whether any part of the request reached the connection.
+type transportError struct {
+	msg     string
+	err     error
+	written bool
+}
+
+func (e *transportError) Error() string {
+	return e.msg
+}
+
+func (e *transportError) Unwrap() error {
+	return e.err
+}
+
+func (p *RetryPolicy) attempts() int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+func (p *RetryPolicy) shouldRetry(req *Request, err error) bool {
+	var tErr *transportError
+	if errors.As(err, &tErr) {
+		return req.Idempotent || !tErr.written
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return req.Idempotent && slices.Contains(p.RetryableStatusCodes, apiErr.StatusCode)
+	}
+	return false
+}
+
+// backoff returns how long to wait before the next attempt. ok is false when
+// the server asked for a longer pause than MaxBackoff allows.
+func (p *RetryPolicy) backoff(attempt int, httpResponse *http.Response) (wait time.Duration, ok bool) {
+	if p.RespectRetryAfter && httpResponse != nil {
+		if d, found := retryAfter(httpResponse.Header.Get("Retry-After"), time.Now()); found {
+			if p.MaxBackoff > 0 && d > p.MaxBackoff {
+				return 0, false
+			}
+			return d, true
+		}
+	}
+
+	wait = p.BaseBackoff << (attempt - 1)
+	if wait <= 0 || (p.MaxBackoff > 0 && wait > p.MaxBackoff) {
+		wait = p.MaxBackoff
+	}
+	if p.Jitter > 0 {
+		wait -= time.Duration(rand.Float64() * p.Jitter * float64(wait))
+	}
+	return wait, true
+}
+
+func retryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(at.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
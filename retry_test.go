@@ -0,0 +1,231 @@
+package jupiter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newRetryTestClient(serverURL string) *Client {
+	client := newTestClient(serverURL)
+	client.Retry = &RetryPolicy{
+		MaxAttempts:          3,
+		BaseBackoff:          time.Millisecond,
+		MaxBackoff:           10 * time.Millisecond,
+		RetryableStatusCodes: []int{http.StatusTooManyRequests, http.StatusServiceUnavailable},
+		RespectRetryAfter:    true,
+	}
+	return client
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestDoCall_RetriesRetryableStatus(t *testing.T) {
+	var calls atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		jsonHandler(t, http.MethodGet, "/test", map[string]string{"hello": "world"})(w, r)
+	})
+	client := newRetryTestClient(server.URL)
+
+	var response map[string]string
+	_, err := client.doCall(context.Background(), NewRequest(client.Url("/test"), nil), &response)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("expected 3 calls, got %d", calls.Load())
+	}
+	if response["hello"] != "world" {
+		t.Errorf("expected hello=world, got %v", response)
+	}
+}
+
+func TestDoCall_RetryGivesUp(t *testing.T) {
+	var calls atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	client := newRetryTestClient(server.URL)
+
+	var response map[string]string
+	_, err := client.doCall(context.Background(), NewRequest(client.Url("/test"), nil), &response)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503 APIError, got %v", err)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("expected 3 calls, got %d", calls.Load())
+	}
+}
+
+func TestDoCall_NoRetryOnNonRetryableStatus(t *testing.T) {
+	var calls atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	client := newRetryTestClient(server.URL)
+
+	var response map[string]string
+	_, err := client.doCall(context.Background(), NewRequest(client.Url("/test"), nil), &response)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 call, got %d", calls.Load())
+	}
+}
+
+func TestDoCall_RetryAfterTooLong(t *testing.T) {
+	var calls atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	client := newRetryTestClient(server.URL)
+
+	var response map[string]string
+	_, err := client.doCall(context.Background(), NewRequest(client.Url("/test"), nil), &response)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 call, got %d", calls.Load())
+	}
+}
+
+func TestDoCall_PostNotRetriedAfterServerSawIt(t *testing.T) {
+	var calls atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	client := newRetryTestClient(server.URL)
+
+	_, err := client.ExecuteUltra(context.Background(), ExecuteRequest{SignedTransaction: "tx", RequestID: "req"})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 call, got %d", calls.Load())
+	}
+}
+
+func TestDoCall_PostNotRetriedAfterBrokenConnection(t *testing.T) {
+	var calls atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Fatalf("hijack: %v", err)
+		}
+		conn.Close()
+	})
+	client := newRetryTestClient(server.URL)
+
+	_, err := client.ExecuteTrigger(context.Background(), ExecuteRequest{SignedTransaction: "tx", RequestID: "req"})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected 1 call, got %d", calls.Load())
+	}
+}
+
+func TestDoCall_PostRetriedBeforeWrite(t *testing.T) {
+	var calls atomic.Int32
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"signedTransaction":"tx","requestId":"req"}` {
+			t.Errorf("unexpected body on retried request: %s", body)
+		}
+		jsonHandler(t, http.MethodPost, "/ultra/v1/execute", ExecuteResponse{Status: "Success"})(w, r)
+	})
+	client := newRetryTestClient(server.URL)
+	client.c = &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		if calls.Add(1) == 1 {
+			return nil, errors.New("dial tcp: connection refused")
+		}
+		return http.DefaultTransport.RoundTrip(r)
+	})}
+
+	result, err := client.ExecuteUltra(context.Background(), ExecuteRequest{SignedTransaction: "tx", RequestID: "req"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Status != "Success" {
+		t.Errorf("expected status Success, got %s", result.Status)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("expected 2 calls, got %d", calls.Load())
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Duration
+		found bool
+	}{
+		{"", 0, false},
+		{"3", 3 * time.Second, true},
+		{"-1", 0, false},
+		{"Wed, 01 Jan 2025 12:00:10 GMT", 10 * time.Second, true},
+		{"Wed, 01 Jan 2025 11:00:00 GMT", 0, true},
+		{"soon", 0, false},
+	}
+
+	for _, tt := range tests {
+		got, found := retryAfter(tt.value, now)
+		if got != tt.want || found != tt.found {
+			t.Errorf("retryAfter(%q) = %v, %v; want %v, %v", tt.value, got, found, tt.want, tt.found)
+		}
+	}
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := &RetryPolicy{BaseBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{1, 100 * time.Millisecond},
+		{2, 200 * time.Millisecond},
+		{4, 800 * time.Millisecond},
+		{5, time.Second},
+		{70, time.Second},
+	}
+
+	for _, tt := range tests {
+		got, ok := policy.backoff(tt.attempt, nil)
+		if !ok || got != tt.want {
+			t.Errorf("backoff(%d) = %v, %v; want %v", tt.attempt, got, ok, tt.want)
+		}
+	}
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		got, _ := policy.backoff(2, nil)
+		if got < 100*time.Millisecond || got > 200*time.Millisecond {
+			t.Fatalf("jittered backoff %v outside [100ms, 200ms]", got)
+		}
+	}
+}
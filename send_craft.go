@@ -0,0 +1,62 @@
+package jupiter
+
+import (
+	"context"
+)
+
+type CraftSendRequest struct {
+	InviteSigner Pubkey `json:"inviteSigner"`
+	Sender       Pubkey `json:"sender"`
+	Amount       Amount `json:"amount"`
+	Mint         Pubkey `json:"mint,omitzero"`
+}
+
+type CraftClawbackRequest struct {
+	InvitePDA Pubkey `json:"invitePDA"`
+	Sender    Pubkey `json:"sender"`
+}
+
+// CraftSendResponse holds an unsigned transaction. Send has no execute
+// endpoint, so the signed transaction is submitted to the network directly.
+type CraftSendResponse struct {
+	Tx     string `json:"tx"`
+	Expiry string `json:"expiry,omitempty"`
+}
+
+func (c *Client) CraftSend(ctx context.Context, body CraftSendRequest) (*CraftSendResponse, error) {
+	if err := requirePubkey("inviteSigner", body.InviteSigner); err != nil {
+		return nil, err
+	}
+	if err := requirePubkey("sender", body.Sender); err != nil {
+		return nil, err
+	}
+	request, err := NewPostRequest(c.Url("/send/v1/craft-send"), body)
+	if err != nil {
+		return nil, err
+	}
+	var response CraftSendResponse
+	_, err = c.doCall(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+func (c *Client) CraftClawback(ctx context.Context, body CraftClawbackRequest) (*CraftSendResponse, error) {
+	if err := requirePubkey("invitePDA", body.InvitePDA); err != nil {
+		return nil, err
+	}
+	if err := requirePubkey("sender", body.Sender); err != nil {
+		return nil, err
+	}
+	request, err := NewPostRequest(c.Url("/send/v1/craft-clawback"), body)
+	if err != nil {
+		return nil, err
+	}
+	var response CraftSendResponse
+	_, err = c.doCall(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
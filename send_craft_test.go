@@ -0,0 +1,132 @@
+package jupiter
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestCraftSend(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/send/v1/craft-send" {
+			t.Errorf("expected path /send/v1/craft-send, got %s", r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var req CraftSendRequest
+		json.Unmarshal(body, &req)
+		if req.InviteSigner != testPayer {
+			t.Errorf("expected InviteSigner %s, got %s", testPayer, req.InviteSigner)
+		}
+		if req.Sender != testSender {
+			t.Errorf("expected Sender %s, got %s", testSender, req.Sender)
+		}
+		if req.Amount != NewAmount(1000000) {
+			t.Errorf("expected Amount 1000000, got %s", req.Amount)
+		}
+		if req.Mint != USDC {
+			t.Errorf("expected Mint %s, got %s", USDC, req.Mint)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(CraftSendResponse{Tx: "send-tx-123"})
+	})
+	client := newTestClient(server.URL)
+
+	result, err := client.CraftSend(context.Background(), CraftSendRequest{
+		InviteSigner: testPayer,
+		Sender:       testSender,
+		Amount:       NewAmount(1000000),
+		Mint:         USDC,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Tx != "send-tx-123" {
+		t.Errorf("expected tx send-tx-123, got %s", result.Tx)
+	}
+}
+
+func TestCraftSend_NativeSol(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var req map[string]any
+		json.Unmarshal(body, &req)
+		if _, ok := req["mint"]; ok {
+			t.Errorf("expected mint to be omitted, got %v", req["mint"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(CraftSendResponse{})
+	})
+	client := newTestClient(server.URL)
+
+	_, err := client.CraftSend(context.Background(), CraftSendRequest{
+		InviteSigner: testPayer,
+		Sender:       testSender,
+		Amount:       NewAmount(1000000000),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCraftSend_Error(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusBadRequest, "invalid amount"))
+	client := newTestClient(server.URL)
+
+	_, err := client.CraftSend(context.Background(), CraftSendRequest{InviteSigner: testPayer, Sender: testSender, Amount: NewAmount(1)})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestCraftClawback(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/send/v1/craft-clawback" {
+			t.Errorf("expected path /send/v1/craft-clawback, got %s", r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var req CraftClawbackRequest
+		json.Unmarshal(body, &req)
+		if req.InvitePDA != testInvite {
+			t.Errorf("expected InvitePDA %s, got %s", testInvite, req.InvitePDA)
+		}
+		if req.Sender != testSender {
+			t.Errorf("expected Sender %s, got %s", testSender, req.Sender)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(CraftSendResponse{Tx: "clawback-tx-456"})
+	})
+	client := newTestClient(server.URL)
+
+	result, err := client.CraftClawback(context.Background(), CraftClawbackRequest{
+		InvitePDA: testInvite,
+		Sender:    testSender,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Tx != "clawback-tx-456" {
+		t.Errorf("expected tx clawback-tx-456, got %s", result.Tx)
+	}
+}
+
+func TestCraftClawback_Error(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusBadRequest, "invite not found"))
+	client := newTestClient(server.URL)
+
+	_, err := client.CraftClawback(context.Background(), CraftClawbackRequest{InvitePDA: testInvite, Sender: testSender})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,58 @@
+package jupiter
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type GetSendInvitesParams struct {
+	Address Pubkey
+	Page    int
+}
+
+type SendInvite struct {
+	InvitePDA Pubkey `json:"invitePDA"`
+	Sender    Pubkey `json:"sender"`
+	Recipient Pubkey `json:"recipient"`
+	Mint      Pubkey `json:"mint"`
+	Amount    Amount `json:"amount"`
+	Status    string `json:"status,omitempty"`
+	Action    string `json:"action,omitempty"`
+	Signature string `json:"signature,omitempty"`
+	CreatedAt string `json:"createdAt,omitempty"`
+	ExpiresAt string `json:"expiresAt,omitempty"`
+}
+
+type SendInvitesResponse struct {
+	Invites     []SendInvite `json:"invites"`
+	HasMoreData bool         `json:"hasMoreData"`
+}
+
+func (c *Client) GetPendingInvites(ctx context.Context, params GetSendInvitesParams) (*SendInvitesResponse, error) {
+	return c.getSendInvites(ctx, "/send/v1/pending-invites", params)
+}
+
+func (c *Client) GetInviteHistory(ctx context.Context, params GetSendInvitesParams) (*SendInvitesResponse, error) {
+	return c.getSendInvites(ctx, "/send/v1/invite-history", params)
+}
+
+func (c *Client) getSendInvites(ctx context.Context, endpoint string, params GetSendInvitesParams) (*SendInvitesResponse, error) {
+	if err := requirePubkey("address", params.Address); err != nil {
+		return nil, err
+	}
+	queryParams := url.Values{}
+	queryParams.Set("address", params.Address.String())
+
+	if params.Page > 0 {
+		queryParams.Set("page", fmt.Sprintf("%d", params.Page))
+	}
+
+	request := NewRequest(c.Url(endpoint), queryParams)
+	var response SendInvitesResponse
+	_, err := c.doCall(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
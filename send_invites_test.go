@@ -0,0 +1,86 @@
+package jupiter
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetPendingInvites(t *testing.T) {
+	invites := SendInvitesResponse{
+		Invites: []SendInvite{
+			{InvitePDA: testInvite, Sender: testSender, Mint: USDC, Amount: NewAmount(1000000)},
+			{InvitePDA: testInvite2, Sender: testSender, Mint: WrappedSOL, Amount: NewAmount(500000000)},
+		},
+		HasMoreData: true,
+	}
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		jsonHandler(t, http.MethodGet, "/send/v1/pending-invites", invites)(w, r)
+		q := r.URL.Query()
+		if q.Get("address") != testSender.String() {
+			t.Errorf("expected address=%s, got %s", testSender, q.Get("address"))
+		}
+		if q.Get("page") != "2" {
+			t.Errorf("expected page=2, got %s", q.Get("page"))
+		}
+	})
+	client := newTestClient(server.URL)
+
+	result, err := client.GetPendingInvites(context.Background(), GetSendInvitesParams{
+		Address: testSender,
+		Page:    2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Invites) != 2 {
+		t.Fatalf("expected 2 invites, got %d", len(result.Invites))
+	}
+	if result.Invites[1].Amount != NewAmount(500000000) {
+		t.Errorf("expected amount 500000000, got %s", result.Invites[1].Amount)
+	}
+	if !result.HasMoreData {
+		t.Error("expected hasMoreData true")
+	}
+}
+
+func TestGetPendingInvites_Error(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusBadRequest, "missing address"))
+	client := newTestClient(server.URL)
+
+	_, err := client.GetPendingInvites(context.Background(), GetSendInvitesParams{Address: testSender})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestGetInviteHistory(t *testing.T) {
+	history := SendInvitesResponse{
+		Invites: []SendInvite{
+			{InvitePDA: testInvite, Sender: testSender, Recipient: testDest, Action: "claim", Signature: "sig1"},
+		},
+	}
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		jsonHandler(t, http.MethodGet, "/send/v1/invite-history", history)(w, r)
+		if r.URL.Query().Has("page") {
+			t.Errorf("expected no page param, got %s", r.URL.Query().Get("page"))
+		}
+	})
+	client := newTestClient(server.URL)
+
+	result, err := client.GetInviteHistory(context.Background(), GetSendInvitesParams{Address: testSender})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Invites) != 1 {
+		t.Fatalf("expected 1 invite, got %d", len(result.Invites))
+	}
+	if result.Invites[0].Recipient != testDest {
+		t.Errorf("expected recipient %s, got %s", testDest, result.Invites[0].Recipient)
+	}
+	if result.HasMoreData {
+		t.Error("expected hasMoreData false")
+	}
+}
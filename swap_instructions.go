@@ -0,0 +1,47 @@
+package jupiter
+
+import (
+	"context"
+)
+
+type AccountMeta struct {
+	Pubkey     Pubkey `json:"pubkey"`
+	IsSigner   bool   `json:"isSigner"`
+	IsWritable bool   `json:"isWritable"`
+}
+
+type Instruction struct {
+	ProgramID Pubkey        `json:"programId"`
+	Accounts  []AccountMeta `json:"accounts"`
+	Data      string        `json:"data"`
+}
+
+type SwapInstructionsResponse struct {
+	TokenLedgerInstruction      *Instruction           `json:"tokenLedgerInstruction,omitempty"`
+	ComputeBudgetInstructions   []Instruction          `json:"computeBudgetInstructions"`
+	SetupInstructions           []Instruction          `json:"setupInstructions"`
+	SwapInstruction             Instruction            `json:"swapInstruction"`
+	CleanupInstruction          *Instruction           `json:"cleanupInstruction,omitempty"`
+	OtherInstructions           []Instruction          `json:"otherInstructions"`
+	AddressLookupTableAddresses []Pubkey               `json:"addressLookupTableAddresses"`
+	PrioritizationFeeLamports   int64                  `json:"prioritizationFeeLamports"`
+	ComputeUnitLimit            int64                  `json:"computeUnitLimit"`
+	DynamicSlippageReport       *DynamicSlippageReport `json:"dynamicSlippageReport,omitempty"`
+	SimulationError             any                    `json:"simulationError,omitempty"`
+}
+
+func (c *Client) GetSwapInstructions(ctx context.Context, body SwapRequest) (*SwapInstructionsResponse, error) {
+	if err := requirePubkey("userPublicKey", body.UserPublicKey); err != nil {
+		return nil, err
+	}
+	request, err := NewPostRequest(c.Url("/swap/v1/swap-instructions"), body)
+	if err != nil {
+		return nil, err
+	}
+	var response SwapInstructionsResponse
+	_, err = c.doCall(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
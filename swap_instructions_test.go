@@ -0,0 +1,96 @@
+package jupiter
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestGetSwapInstructions(t *testing.T) {
+	resp := SwapInstructionsResponse{
+		ComputeBudgetInstructions: []Instruction{
+			{ProgramID: testPubkey(20), Data: "AsBcFQA="},
+		},
+		SetupInstructions: []Instruction{
+			{
+				ProgramID: testPubkey(21),
+				Accounts: []AccountMeta{
+					{Pubkey: testUser, IsSigner: true, IsWritable: true},
+				},
+				Data: "AQ==",
+			},
+		},
+		SwapInstruction: Instruction{
+			ProgramID: testPubkey(22),
+			Accounts: []AccountMeta{
+				{Pubkey: testUser, IsSigner: true, IsWritable: false},
+				{Pubkey: testAMM, IsSigner: false, IsWritable: true},
+			},
+			Data: "5RfLl3rjrSo=",
+		},
+		CleanupInstruction:          &Instruction{ProgramID: TokenProgram, Data: "CQ=="},
+		OtherInstructions:           []Instruction{},
+		AddressLookupTableAddresses: []Pubkey{testLookup, testPubkey(23)},
+	}
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/swap/v1/swap-instructions" {
+			t.Errorf("expected path /swap/v1/swap-instructions, got %s", r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var req map[string]any
+		json.Unmarshal(body, &req)
+		fee, _ := req["prioritizationFeeLamports"].(map[string]any)
+		if fee["jitoTipLamports"] != float64(5000) {
+			t.Errorf("expected jitoTipLamports 5000, got %v", req["prioritizationFeeLamports"])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+	client := newTestClient(server.URL)
+
+	tip := int64(5000)
+	result, err := client.GetSwapInstructions(context.Background(), SwapRequest{
+		UserPublicKey:             testUser,
+		QuoteResponse:             SwapQuoteResponse{InputMint: WrappedSOL},
+		PrioritizationFeeLamports: &PrioritizationFeeLamports{JitoTipLamports: &tip},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.SetupInstructions) != 1 {
+		t.Fatalf("expected 1 setup instruction, got %d", len(result.SetupInstructions))
+	}
+	if !result.SetupInstructions[0].Accounts[0].IsSigner {
+		t.Error("expected setup instruction account to be signer")
+	}
+	if len(result.SwapInstruction.Accounts) != 2 {
+		t.Fatalf("expected 2 swap instruction accounts, got %d", len(result.SwapInstruction.Accounts))
+	}
+	if result.CleanupInstruction == nil || result.CleanupInstruction.ProgramID != TokenProgram {
+		t.Errorf("expected cleanup instruction for the token program, got %v", result.CleanupInstruction)
+	}
+	if result.TokenLedgerInstruction != nil {
+		t.Errorf("expected no token ledger instruction, got %v", result.TokenLedgerInstruction)
+	}
+	if len(result.AddressLookupTableAddresses) != 2 {
+		t.Errorf("expected 2 lookup table addresses, got %d", len(result.AddressLookupTableAddresses))
+	}
+}
+
+func TestGetSwapInstructions_Error(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusBadRequest, "invalid quote"))
+	client := newTestClient(server.URL)
+
+	_, err := client.GetSwapInstructions(context.Background(), SwapRequest{UserPublicKey: testUser})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -2,33 +2,34 @@ package jupiter
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 )
 
 type SwapQuoteParams struct {
-	InputMint                  string
-	OutputMint                 string
-	Amount                     string
-	SlippageBps                int
+	InputMint                  Pubkey
+	OutputMint                 Pubkey
+	Amount                     Amount
+	SlippageBps                Bps
 	SwapMode                   string
 	Dexes                      string
 	ExcludeDexes               string
 	RestrictIntermediateTokens *bool
 	OnlyDirectRoutes           bool
 	AsLegacyTransaction        bool
-	PlatformFeeBps             int
+	PlatformFeeBps             Bps
 	MaxAccounts                int
 }
 
 type SwapQuoteResponse struct {
-	InputMint            string          `json:"inputMint"`
-	InAmount             string          `json:"inAmount"`
-	OutputMint           string          `json:"outputMint"`
-	OutAmount            string          `json:"outAmount"`
-	OtherAmountThreshold string          `json:"otherAmountThreshold"`
+	InputMint            Pubkey          `json:"inputMint"`
+	InAmount             Amount          `json:"inAmount"`
+	OutputMint           Pubkey          `json:"outputMint"`
+	OutAmount            Amount          `json:"outAmount"`
+	OtherAmountThreshold Amount          `json:"otherAmountThreshold"`
 	SwapMode             string          `json:"swapMode"`
-	SlippageBps          int             `json:"slippageBps"`
+	SlippageBps          Bps             `json:"slippageBps"`
 	PriceImpactPct       string          `json:"priceImpactPct"`
 	RoutePlan            []RoutePlanStep `json:"routePlan"`
 	PlatformFee          *PlatformFee    `json:"platformFee,omitempty"`
@@ -36,12 +37,37 @@ type SwapQuoteResponse struct {
 	TimeTaken            *float64        `json:"timeTaken,omitempty"`
 }
 
+func (p SwapQuoteParams) validate() error {
+	if err := requirePubkey("inputMint", p.InputMint); err != nil {
+		return err
+	}
+	if err := requirePubkey("outputMint", p.OutputMint); err != nil {
+		return err
+	}
+	if p.InputMint == p.OutputMint {
+		return &FieldError{Field: "outputMint", Err: errors.New("must differ from inputMint")}
+	}
+	if p.Amount.IsZero() {
+		return &FieldError{Field: "amount", Err: errors.New("must be greater than zero")}
+	}
+	if !p.SlippageBps.Valid() {
+		return &FieldError{Field: "slippageBps", Err: fmt.Errorf("%d exceeds %d", p.SlippageBps, MaxBps)}
+	}
+	if !p.PlatformFeeBps.Valid() {
+		return &FieldError{Field: "platformFeeBps", Err: fmt.Errorf("%d exceeds %d", p.PlatformFeeBps, MaxBps)}
+	}
+	return nil
+}
+
 func (c *Client) GetSwapQuote(ctx context.Context, params SwapQuoteParams) (*SwapQuoteResponse, error) {
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
 	queryParams := url.Values{}
 
-	queryParams.Set("inputMint", params.InputMint)
-	queryParams.Set("outputMint", params.OutputMint)
-	queryParams.Set("amount", params.Amount)
+	queryParams.Set("inputMint", params.InputMint.String())
+	queryParams.Set("outputMint", params.OutputMint.String())
+	queryParams.Set("amount", params.Amount.String())
 
 	if params.SlippageBps > 0 {
 		queryParams.Set("slippageBps", fmt.Sprintf("%d", params.SlippageBps))
@@ -73,7 +99,7 @@ func (c *Client) GetSwapQuote(ctx context.Context, params SwapQuoteParams) (*Swa
 
 	request := NewRequest(c.Url("/swap/v1/quote"), queryParams)
 	var response SwapQuoteResponse
-	_, err := c.doCall(ctx, request, &response)
+	_, err := c.doHedgedCall(ctx, request, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -4,28 +4,29 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"reflect"
 	"testing"
 )
 
 func TestGetSwapQuote(t *testing.T) {
 	quote := SwapQuoteResponse{
-		InputMint:            "SOL111",
-		InAmount:             "1000000000",
-		OutputMint:           "USDC111",
-		OutAmount:            "15025000",
-		OtherAmountThreshold: "15000000",
+		InputMint:            WrappedSOL,
+		InAmount:             NewAmount(1000000000),
+		OutputMint:           USDC,
+		OutAmount:            NewAmount(15025000),
+		OtherAmountThreshold: NewAmount(15000000),
 		SwapMode:             "ExactIn",
 		SlippageBps:          50,
 		PriceImpactPct:       "0.01",
 		RoutePlan: []RoutePlanStep{
 			{
 				SwapInfo: SwapInfo{
-					AmmKey:     "amm123",
+					AmmKey:     testAMM,
 					Label:      "Raydium",
-					InputMint:  "SOL111",
-					OutputMint: "USDC111",
-					InAmount:   "1000000000",
-					OutAmount:  "15025000",
+					InputMint:  WrappedSOL,
+					OutputMint: USDC,
+					InAmount:   NewAmount(1000000000),
+					OutAmount:  NewAmount(15025000),
 				},
 			},
 		},
@@ -39,11 +40,11 @@ func TestGetSwapQuote(t *testing.T) {
 			t.Errorf("expected path /swap/v1/quote, got %s", r.URL.Path)
 		}
 		q := r.URL.Query()
-		if q.Get("inputMint") != "SOL111" {
-			t.Errorf("expected inputMint=SOL111, got %s", q.Get("inputMint"))
+		if q.Get("inputMint") != WrappedSOL.String() {
+			t.Errorf("expected inputMint=%s, got %s", WrappedSOL, q.Get("inputMint"))
 		}
-		if q.Get("outputMint") != "USDC111" {
-			t.Errorf("expected outputMint=USDC111, got %s", q.Get("outputMint"))
+		if q.Get("outputMint") != USDC.String() {
+			t.Errorf("expected outputMint=%s, got %s", USDC, q.Get("outputMint"))
 		}
 		if q.Get("amount") != "1000000000" {
 			t.Errorf("expected amount=1000000000, got %s", q.Get("amount"))
@@ -54,17 +55,17 @@ func TestGetSwapQuote(t *testing.T) {
 	client := newTestClient(server.URL)
 
 	result, err := client.GetSwapQuote(context.Background(), SwapQuoteParams{
-		InputMint:  "SOL111",
-		OutputMint: "USDC111",
-		Amount:     "1000000000",
+		InputMint:  WrappedSOL,
+		OutputMint: USDC,
+		Amount:     NewAmount(1000000000),
 	})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if result.InputMint != "SOL111" {
-		t.Errorf("expected InputMint SOL111, got %s", result.InputMint)
+	if result.InputMint != WrappedSOL {
+		t.Errorf("expected InputMint %s, got %s", WrappedSOL, result.InputMint)
 	}
-	if result.OutAmount != "15025000" {
+	if result.OutAmount != NewAmount(15025000) {
 		t.Errorf("expected OutAmount 15025000, got %s", result.OutAmount)
 	}
 	if len(result.RoutePlan) != 1 {
@@ -113,9 +114,9 @@ func TestGetSwapQuote_AllParams(t *testing.T) {
 	client := newTestClient(server.URL)
 
 	_, err := client.GetSwapQuote(context.Background(), SwapQuoteParams{
-		InputMint:                  "SOL",
-		OutputMint:                 "USDC",
-		Amount:                     "1000",
+		InputMint:                  WrappedSOL,
+		OutputMint:                 USDC,
+		Amount:                     NewAmount(1000),
 		SlippageBps:                100,
 		SwapMode:                   "ExactOut",
 		Dexes:                      "Raydium",
@@ -135,8 +136,63 @@ func TestGetSwapQuote_Error(t *testing.T) {
 	server := newTestServer(t, errorHandler(http.StatusBadRequest, "missing params"))
 	client := newTestClient(server.URL)
 
-	_, err := client.GetSwapQuote(context.Background(), SwapQuoteParams{})
+	_, err := client.GetSwapQuote(context.Background(), SwapQuoteParams{InputMint: WrappedSOL, OutputMint: USDC, Amount: NewAmount(1)})
 	if err == nil {
 		t.Fatal("expected error")
 	}
 }
+
+func TestSwapQuoteResponse_RoundTrip(t *testing.T) {
+	recorded := `{
+		"inputMint": "So11111111111111111111111111111111111111112",
+		"inAmount": "1000000000",
+		"outputMint": "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v",
+		"outAmount": "150250000",
+		"otherAmountThreshold": "149498750",
+		"swapMode": "ExactIn",
+		"slippageBps": 50,
+		"priceImpactPct": "0",
+		"routePlan": [
+			{
+				"swapInfo": {
+					"ammKey": "TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA",
+					"label": "Meteora DLMM",
+					"inputMint": "So11111111111111111111111111111111111111112",
+					"outputMint": "EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v",
+					"inAmount": "1000000000",
+					"outAmount": "150250000",
+					"feeAmount": "0",
+					"feeMint": "11111111111111111111111111111111"
+				},
+				"percent": 100
+			}
+		],
+		"contextSlot": 299283763,
+		"timeTaken": 0.0012
+	}`
+
+	var quote SwapQuoteResponse
+	if err := json.Unmarshal([]byte(recorded), &quote); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out, err := json.Marshal(SwapRequest{UserPublicKey: testUser, QuoteResponse: quote})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var sent struct {
+		QuoteResponse any `json:"quoteResponse"`
+	}
+	if err := json.Unmarshal(out, &sent); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var want any
+	if err := json.Unmarshal([]byte(recorded), &want); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(sent.QuoteResponse, want) {
+		got, _ := json.Marshal(sent.QuoteResponse)
+		wantJSON, _ := json.Marshal(want)
+		t.Errorf("re-encoded quote differs:\n got %s\nwant %s", got, wantJSON)
+	}
+}
@@ -0,0 +1,93 @@
+package jupiter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type PriorityLevelWithMaxLamports struct {
+	PriorityLevel string `json:"priorityLevel"`
+	MaxLamports   int64  `json:"maxLamports"`
+	Global        *bool  `json:"global,omitempty"`
+}
+
+// PrioritizationFeeLamports is marshalled into one of the forms accepted by
+// the swap endpoints: a plain lamport amount, "auto", or an object holding
+// either priorityLevelWithMaxLamports or jitoTipLamports. Exactly one field
+// should be set.
+type PrioritizationFeeLamports struct {
+	Lamports                     *int64
+	Auto                         bool
+	PriorityLevelWithMaxLamports *PriorityLevelWithMaxLamports
+	JitoTipLamports              *int64
+}
+
+func (p PrioritizationFeeLamports) MarshalJSON() ([]byte, error) {
+	switch {
+	case p.PriorityLevelWithMaxLamports != nil:
+		return json.Marshal(struct {
+			PriorityLevelWithMaxLamports *PriorityLevelWithMaxLamports `json:"priorityLevelWithMaxLamports"`
+		}{p.PriorityLevelWithMaxLamports})
+	case p.JitoTipLamports != nil:
+		return json.Marshal(struct {
+			JitoTipLamports int64 `json:"jitoTipLamports"`
+		}{*p.JitoTipLamports})
+	case p.Lamports != nil:
+		return json.Marshal(*p.Lamports)
+	case p.Auto:
+		return json.Marshal("auto")
+	}
+	return nil, fmt.Errorf("prioritizationFeeLamports: no value set")
+}
+
+type SwapRequest struct {
+	UserPublicKey             Pubkey                     `json:"userPublicKey"`
+	QuoteResponse             SwapQuoteResponse          `json:"quoteResponse"`
+	WrapAndUnwrapSol          *bool                      `json:"wrapAndUnwrapSol,omitempty"`
+	UseSharedAccounts         *bool                      `json:"useSharedAccounts,omitempty"`
+	FeeAccount                Pubkey                     `json:"feeAccount,omitzero"`
+	TrackingAccount           Pubkey                     `json:"trackingAccount,omitzero"`
+	PrioritizationFeeLamports *PrioritizationFeeLamports `json:"prioritizationFeeLamports,omitempty"`
+	AsLegacyTransaction       bool                       `json:"asLegacyTransaction,omitempty"`
+	DestinationTokenAccount   Pubkey                     `json:"destinationTokenAccount,omitzero"`
+	DynamicComputeUnitLimit   bool                       `json:"dynamicComputeUnitLimit,omitempty"`
+	SkipUserAccountsRpcCalls  bool                       `json:"skipUserAccountsRpcCalls,omitempty"`
+	DynamicSlippage           bool                       `json:"dynamicSlippage,omitempty"`
+}
+
+type DynamicSlippageReport struct {
+	SlippageBps                  int      `json:"slippageBps"`
+	OtherAmount                  *int64   `json:"otherAmount,omitempty"`
+	SimulatedIncurredSlippageBps *int     `json:"simulatedIncurredSlippageBps,omitempty"`
+	AmplificationRatio           string   `json:"amplificationRatio,omitempty"`
+	CategoryName                 string   `json:"categoryName,omitempty"`
+	HeuristicMaxSlippageBps      *int     `json:"heuristicMaxSlippageBps,omitempty"`
+	RtseSlippageBps              *int     `json:"rtseSlippageBps,omitempty"`
+	FailedTxnEstSlippage         *float64 `json:"failedTxnEstSlippage,omitempty"`
+}
+
+type SwapResponse struct {
+	SwapTransaction           string                 `json:"swapTransaction"`
+	LastValidBlockHeight      int64                  `json:"lastValidBlockHeight"`
+	PrioritizationFeeLamports int64                  `json:"prioritizationFeeLamports"`
+	ComputeUnitLimit          int64                  `json:"computeUnitLimit"`
+	DynamicSlippageReport     *DynamicSlippageReport `json:"dynamicSlippageReport,omitempty"`
+	SimulationError           any                    `json:"simulationError,omitempty"`
+}
+
+func (c *Client) BuildSwapTransaction(ctx context.Context, body SwapRequest) (*SwapResponse, error) {
+	if err := requirePubkey("userPublicKey", body.UserPublicKey); err != nil {
+		return nil, err
+	}
+	request, err := NewPostRequest(c.Url("/swap/v1/swap"), body)
+	if err != nil {
+		return nil, err
+	}
+	var response SwapResponse
+	_, err = c.doCall(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
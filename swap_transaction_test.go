@@ -0,0 +1,131 @@
+package jupiter
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestBuildSwapTransaction(t *testing.T) {
+	wrapSol := true
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/swap/v1/swap" {
+			t.Errorf("expected path /swap/v1/swap, got %s", r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var req map[string]any
+		json.Unmarshal(body, &req)
+		if req["userPublicKey"] != testUser.String() {
+			t.Errorf("expected userPublicKey %s, got %v", testUser, req["userPublicKey"])
+		}
+		if req["wrapAndUnwrapSol"] != true {
+			t.Errorf("expected wrapAndUnwrapSol true, got %v", req["wrapAndUnwrapSol"])
+		}
+		if req["dynamicComputeUnitLimit"] != true {
+			t.Errorf("expected dynamicComputeUnitLimit true, got %v", req["dynamicComputeUnitLimit"])
+		}
+		if req["destinationTokenAccount"] != testDest.String() {
+			t.Errorf("expected destinationTokenAccount %s, got %v", testDest, req["destinationTokenAccount"])
+		}
+		quote, _ := req["quoteResponse"].(map[string]any)
+		if quote["inAmount"] != "1000000000" {
+			t.Errorf("expected quoteResponse.inAmount 1000000000, got %v", quote["inAmount"])
+		}
+		if _, ok := req["feeAccount"]; ok {
+			t.Errorf("expected feeAccount to be omitted")
+		}
+
+		resp := SwapResponse{
+			SwapTransaction:           "swap-tx-123",
+			LastValidBlockHeight:      279632475,
+			PrioritizationFeeLamports: 9999,
+			ComputeUnitLimit:          388876,
+			DynamicSlippageReport:     &DynamicSlippageReport{SlippageBps: 12},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+	client := newTestClient(server.URL)
+
+	result, err := client.BuildSwapTransaction(context.Background(), SwapRequest{
+		UserPublicKey:           testUser,
+		QuoteResponse:           SwapQuoteResponse{InputMint: WrappedSOL, InAmount: NewAmount(1000000000)},
+		WrapAndUnwrapSol:        &wrapSol,
+		DynamicComputeUnitLimit: true,
+		DestinationTokenAccount: testDest,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.SwapTransaction != "swap-tx-123" {
+		t.Errorf("expected swapTransaction swap-tx-123, got %s", result.SwapTransaction)
+	}
+	if result.LastValidBlockHeight != 279632475 {
+		t.Errorf("expected lastValidBlockHeight 279632475, got %d", result.LastValidBlockHeight)
+	}
+	if result.ComputeUnitLimit != 388876 {
+		t.Errorf("expected computeUnitLimit 388876, got %d", result.ComputeUnitLimit)
+	}
+	if result.DynamicSlippageReport == nil || result.DynamicSlippageReport.SlippageBps != 12 {
+		t.Errorf("expected dynamicSlippageReport.slippageBps 12, got %v", result.DynamicSlippageReport)
+	}
+}
+
+func TestBuildSwapTransaction_Error(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusBadRequest, "invalid quote"))
+	client := newTestClient(server.URL)
+
+	_, err := client.BuildSwapTransaction(context.Background(), SwapRequest{UserPublicKey: testUser})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestPrioritizationFeeLamports_MarshalJSON(t *testing.T) {
+	lamports := int64(1000)
+	tip := int64(5000)
+	global := false
+
+	tests := []struct {
+		name string
+		fee  PrioritizationFeeLamports
+		want string
+	}{
+		{"lamports", PrioritizationFeeLamports{Lamports: &lamports}, `1000`},
+		{"auto", PrioritizationFeeLamports{Auto: true}, `"auto"`},
+		{"jito tip", PrioritizationFeeLamports{JitoTipLamports: &tip}, `{"jitoTipLamports":5000}`},
+		{
+			"priority level",
+			PrioritizationFeeLamports{PriorityLevelWithMaxLamports: &PriorityLevelWithMaxLamports{
+				PriorityLevel: "veryHigh",
+				MaxLamports:   1000000,
+				Global:        &global,
+			}},
+			`{"priorityLevelWithMaxLamports":{"priorityLevel":"veryHigh","maxLamports":1000000,"global":false}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := json.Marshal(tt.fee)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestPrioritizationFeeLamports_Empty(t *testing.T) {
+	_, err := NewPostRequest("/test", SwapRequest{PrioritizationFeeLamports: &PrioritizationFeeLamports{}})
+	if err == nil {
+		t.Fatal("expected error for empty prioritizationFeeLamports")
+	}
+}
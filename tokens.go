@@ -7,14 +7,24 @@ import (
 )
 
 type TokenV2 struct {
-	ID                string      `json:"id"`
+	ID                Pubkey      `json:"id"`
 	Name              string      `json:"name"`
 	Symbol            string      `json:"symbol"`
 	Icon              string      `json:"icon,omitempty"`
 	Decimals          int         `json:"decimals"`
-	CircSupply        *float64    `json:"circSupply,omitempty"`
-	TotalSupply       *float64    `json:"totalSupply,omitempty"`
-	TokenProgram      string      `json:"tokenProgram,omitempty"`
+	Twitter           string      `json:"twitter,omitempty"`
+	Telegram          string      `json:"telegram,omitempty"`
+	Website           string      `json:"website,omitempty"`
+	Dev               Pubkey      `json:"dev,omitzero"`
+	CircSupply        *Decimal    `json:"circSupply,omitempty"`
+	TotalSupply       *Decimal    `json:"totalSupply,omitempty"`
+	TokenProgram      Pubkey      `json:"tokenProgram,omitzero"`
+	Launchpad         string      `json:"launchpad,omitempty"`
+	PartnerConfig     string      `json:"partnerConfig,omitempty"`
+	MintAuthority     Pubkey      `json:"mintAuthority,omitzero"`
+	FreezeAuthority   Pubkey      `json:"freezeAuthority,omitzero"`
+	GraduatedPool     Pubkey      `json:"graduatedPool,omitzero"`
+	GraduatedAt       string      `json:"graduatedAt,omitempty"`
 	FirstPool         *FirstPool  `json:"firstPool,omitempty"`
 	HolderCount       *int        `json:"holderCount,omitempty"`
 	Audit             *Audit      `json:"audit,omitempty"`
@@ -25,33 +35,124 @@ type TokenV2 struct {
 	Tags              []string    `json:"tags,omitempty"`
 	FDV               *float64    `json:"fdv,omitempty"`
 	MCap              *float64    `json:"mcap,omitempty"`
-	USDPrice          *float64    `json:"usdPrice,omitempty"`
+	USDPrice          *Decimal    `json:"usdPrice,omitempty"`
 	PriceBlockID      *int64      `json:"priceBlockId,omitempty"`
 	Liquidity         *float64    `json:"liquidity,omitempty"`
 	Stats5m           *TokenStats `json:"stats5m,omitempty"`
 	Stats1h           *TokenStats `json:"stats1h,omitempty"`
 	Stats6h           *TokenStats `json:"stats6h,omitempty"`
 	Stats24h          *TokenStats `json:"stats24h,omitempty"`
+	CtLikes           *int        `json:"ctLikes,omitempty"`
+	SmartCtLikes      *int        `json:"smartCtLikes,omitempty"`
 	UpdatedAt         string      `json:"updatedAt,omitempty"`
 }
 
+// GetTokensParams selects a token list.
+//
+// Deprecated: use GetTokensByCategoryParams.
 type GetTokensParams struct {
-	SortBy   string
-	Interval string
+	SortBy   TokenCategory
+	Interval TokenInterval
 	Limit    int
 }
 
+// GetTokens returns the tokens in the SortBy category over Interval. Both
+// are required.
+//
+// Deprecated: use GetTokensByCategory.
 func (c *Client) GetTokens(ctx context.Context, params GetTokensParams) ([]TokenV2, error) {
+	return c.GetTokensByCategory(ctx, GetTokensByCategoryParams{
+		Category: params.SortBy,
+		Interval: params.Interval,
+		Limit:    params.Limit,
+	})
+}
+
+type SearchTokensParams struct {
+	Query string
+}
+
+func (c *Client) SearchTokens(ctx context.Context, params SearchTokensParams) ([]TokenV2, error) {
 	queryParams := url.Values{}
+	queryParams.Set("query", params.Query)
+
+	request := NewRequest(c.Url("/tokens/v2/search"), queryParams)
+	var response []TokenV2
+	_, err := c.doCall(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+type TokenCategory string
+
+const (
+	TokenCategoryTopOrganicScore TokenCategory = "toporganicscore"
+	TokenCategoryTopTraded       TokenCategory = "toptraded"
+	TokenCategoryTopTrending     TokenCategory = "toptrending"
+)
+
+func (c TokenCategory) Valid() bool {
+	switch c {
+	case TokenCategoryTopOrganicScore, TokenCategoryTopTraded, TokenCategoryTopTrending:
+		return true
+	}
+	return false
+}
+
+type TokenInterval string
+
+const (
+	TokenInterval5m  TokenInterval = "5m"
+	TokenInterval1h  TokenInterval = "1h"
+	TokenInterval6h  TokenInterval = "6h"
+	TokenInterval24h TokenInterval = "24h"
+)
+
+func (i TokenInterval) Valid() bool {
+	switch i {
+	case TokenInterval5m, TokenInterval1h, TokenInterval6h, TokenInterval24h:
+		return true
+	}
+	return false
+}
 
+type TokenTag string
+
+const (
+	TokenTagVerified TokenTag = "verified"
+	TokenTagLST      TokenTag = "lst"
+)
+
+func (t TokenTag) Valid() bool {
+	switch t {
+	case TokenTagVerified, TokenTagLST:
+		return true
+	}
+	return false
+}
+
+type GetTokensByCategoryParams struct {
+	Category TokenCategory
+	Interval TokenInterval
+	Limit    int
+}
+
+func (c *Client) GetTokensByCategory(ctx context.Context, params GetTokensByCategoryParams) ([]TokenV2, error) {
+	if !params.Category.Valid() {
+		return nil, fmt.Errorf("invalid token category %q", params.Category)
+	}
+	if !params.Interval.Valid() {
+		return nil, fmt.Errorf("invalid token interval %q", params.Interval)
+	}
+
+	queryParams := url.Values{}
 	if params.Limit > 0 {
 		queryParams.Set("limit", fmt.Sprintf("%d", params.Limit))
 	}
 
-	endpoint := fmt.Sprintf("/tokens/v2/%s", params.SortBy)
-	if params.Interval != "" {
-		endpoint = fmt.Sprintf("/tokens/v2/%s/%s", params.SortBy, params.Interval)
-	}
+	endpoint := fmt.Sprintf("/tokens/v2/%s/%s", params.Category, params.Interval)
 	request := NewRequest(c.Url(endpoint), queryParams)
 	var response []TokenV2
 	_, err := c.doCall(ctx, request, &response)
@@ -61,15 +162,25 @@ func (c *Client) GetTokens(ctx context.Context, params GetTokensParams) ([]Token
 	return response, nil
 }
 
-type SearchTokensParams struct {
-	Query string
-}
+func (c *Client) GetTokensByTag(ctx context.Context, tag TokenTag) ([]TokenV2, error) {
+	if !tag.Valid() {
+		return nil, fmt.Errorf("invalid token tag %q", tag)
+	}
 
-func (c *Client) SearchTokens(ctx context.Context, params SearchTokensParams) ([]TokenV2, error) {
 	queryParams := url.Values{}
-	queryParams.Set("query", params.Query)
+	queryParams.Set("query", string(tag))
 
-	request := NewRequest(c.Url("/tokens/v2/search"), queryParams)
+	request := NewRequest(c.Url("/tokens/v2/tag"), queryParams)
+	var response []TokenV2
+	_, err := c.doCall(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *Client) GetRecentTokens(ctx context.Context) ([]TokenV2, error) {
+	request := NewRequest(c.Url("/tokens/v2/recent"), url.Values{})
 	var response []TokenV2
 	_, err := c.doCall(ctx, request, &response)
 	if err != nil {
@@ -9,16 +9,16 @@ import (
 
 func TestGetTokens(t *testing.T) {
 	tokens := []TokenV2{
-		{ID: "SOL111", Name: "Solana", Symbol: "SOL", Decimals: 9},
-		{ID: "USDC111", Name: "USD Coin", Symbol: "USDC", Decimals: 6},
+		{ID: WrappedSOL, Name: "Solana", Symbol: "SOL", Decimals: 9},
+		{ID: USDC, Name: "USD Coin", Symbol: "USDC", Decimals: 6},
 	}
 
 	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			t.Errorf("expected GET, got %s", r.Method)
 		}
-		if r.URL.Path != "/tokens/v2/trending" {
-			t.Errorf("expected path /tokens/v2/trending, got %s", r.URL.Path)
+		if r.URL.Path != "/tokens/v2/toptrending/24h" {
+			t.Errorf("expected path /tokens/v2/toptrending/24h, got %s", r.URL.Path)
 		}
 		if r.URL.Query().Get("limit") != "10" {
 			t.Errorf("expected limit=10, got %s", r.URL.Query().Get("limit"))
@@ -29,8 +29,9 @@ func TestGetTokens(t *testing.T) {
 	client := newTestClient(server.URL)
 
 	result, err := client.GetTokens(context.Background(), GetTokensParams{
-		SortBy: "trending",
-		Limit:  10,
+		SortBy:   TokenCategoryTopTrending,
+		Interval: TokenInterval24h,
+		Limit:    10,
 	})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -48,8 +49,8 @@ func TestGetTokens(t *testing.T) {
 
 func TestGetTokens_WithInterval(t *testing.T) {
 	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
-		if r.URL.Path != "/tokens/v2/volume/1h" {
-			t.Errorf("expected path /tokens/v2/volume/1h, got %s", r.URL.Path)
+		if r.URL.Path != "/tokens/v2/toptraded/1h" {
+			t.Errorf("expected path /tokens/v2/toptraded/1h, got %s", r.URL.Path)
 		}
 		w.Header().Set("Content-Type", "application/json")
 		json.NewEncoder(w).Encode([]TokenV2{})
@@ -57,8 +58,8 @@ func TestGetTokens_WithInterval(t *testing.T) {
 	client := newTestClient(server.URL)
 
 	_, err := client.GetTokens(context.Background(), GetTokensParams{
-		SortBy:   "volume",
-		Interval: "1h",
+		SortBy:   TokenCategoryTopTraded,
+		Interval: TokenInterval1h,
 	})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -76,7 +77,8 @@ func TestGetTokens_NoLimit(t *testing.T) {
 	client := newTestClient(server.URL)
 
 	_, err := client.GetTokens(context.Background(), GetTokensParams{
-		SortBy: "trending",
+		SortBy:   TokenCategoryTopTrending,
+		Interval: TokenInterval24h,
 	})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -87,7 +89,7 @@ func TestGetTokens_Error(t *testing.T) {
 	server := newTestServer(t, errorHandler(http.StatusInternalServerError, "error"))
 	client := newTestClient(server.URL)
 
-	_, err := client.GetTokens(context.Background(), GetTokensParams{SortBy: "trending"})
+	_, err := client.GetTokens(context.Background(), GetTokensParams{SortBy: TokenCategoryTopTrending, Interval: TokenInterval24h})
 	if err == nil {
 		t.Fatal("expected error")
 	}
@@ -95,7 +97,7 @@ func TestGetTokens_Error(t *testing.T) {
 
 func TestSearchTokens(t *testing.T) {
 	tokens := []TokenV2{
-		{ID: "SOL111", Name: "Solana", Symbol: "SOL", Decimals: 9},
+		{ID: WrappedSOL, Name: "Solana", Symbol: "SOL", Decimals: 9},
 	}
 
 	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
@@ -134,3 +136,153 @@ func TestSearchTokens_Error(t *testing.T) {
 		t.Fatal("expected error")
 	}
 }
+
+func TestGetTokensByCategory(t *testing.T) {
+	tokens := []TokenV2{
+		{ID: testMint, Name: "Jupiter", Symbol: "JUP", Decimals: 6},
+	}
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/tokens/v2/toptrending/24h" {
+			t.Errorf("expected path /tokens/v2/toptrending/24h, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("limit") != "50" {
+			t.Errorf("expected limit=50, got %s", r.URL.Query().Get("limit"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(tokens)
+	})
+	client := newTestClient(server.URL)
+
+	result, err := client.GetTokensByCategory(context.Background(), GetTokensByCategoryParams{
+		Category: TokenCategoryTopTrending,
+		Interval: TokenInterval24h,
+		Limit:    50,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 1 {
+		t.Fatalf("expected 1 token, got %d", len(result))
+	}
+	if result[0].Symbol != "JUP" {
+		t.Errorf("expected token JUP, got %s", result[0].Symbol)
+	}
+}
+
+func TestGetTokens_InvalidParams(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	})
+	client := newTestClient(server.URL)
+
+	tests := []struct {
+		name   string
+		params GetTokensParams
+	}{
+		{"bad sort", GetTokensParams{SortBy: "trending"}},
+		{"missing sort", GetTokensParams{Interval: TokenInterval1h}},
+		{"bad interval", GetTokensParams{SortBy: TokenCategoryTopTraded, Interval: "24hr"}},
+		{"missing interval", GetTokensParams{SortBy: TokenCategoryTopTraded}},
+	}
+
+	for _, tt := range tests {
+		_, err := client.GetTokens(context.Background(), tt.params)
+		if err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+}
+
+func TestGetTokensByCategory_InvalidParams(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	})
+	client := newTestClient(server.URL)
+
+	tests := []struct {
+		name   string
+		params GetTokensByCategoryParams
+	}{
+		{"bad category", GetTokensByCategoryParams{Category: "trending", Interval: TokenInterval1h}},
+		{"bad interval", GetTokensByCategoryParams{Category: TokenCategoryTopTraded, Interval: "24hr"}},
+		{"missing interval", GetTokensByCategoryParams{Category: TokenCategoryTopTraded}},
+	}
+
+	for _, tt := range tests {
+		_, err := client.GetTokensByCategory(context.Background(), tt.params)
+		if err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+}
+
+func TestGetTokensByTag(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tokens/v2/tag" {
+			t.Errorf("expected path /tokens/v2/tag, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("query") != "lst" {
+			t.Errorf("expected query=lst, got %s", r.URL.Query().Get("query"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]TokenV2{{ID: testMint, Symbol: "mSOL", Tags: []string{"lst"}}})
+	})
+	client := newTestClient(server.URL)
+
+	result, err := client.GetTokensByTag(context.Background(), TokenTagLST)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 1 {
+		t.Fatalf("expected 1 token, got %d", len(result))
+	}
+	if result[0].Symbol != "mSOL" {
+		t.Errorf("expected token mSOL, got %s", result[0].Symbol)
+	}
+}
+
+func TestGetTokensByTag_InvalidTag(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	})
+	client := newTestClient(server.URL)
+
+	_, err := client.GetTokensByTag(context.Background(), "verfied")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestGetRecentTokens(t *testing.T) {
+	tokens := []TokenV2{
+		{ID: testMint, Name: "New Token", Symbol: "NEW", Decimals: 6},
+	}
+
+	server := newTestServer(t, jsonHandler(t, http.MethodGet, "/tokens/v2/recent", tokens))
+	client := newTestClient(server.URL)
+
+	result, err := client.GetRecentTokens(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 1 {
+		t.Fatalf("expected 1 token, got %d", len(result))
+	}
+	if result[0].Symbol != "NEW" {
+		t.Errorf("expected token NEW, got %s", result[0].Symbol)
+	}
+}
+
+func TestGetRecentTokens_Error(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusInternalServerError, "error"))
+	client := newTestClient(server.URL)
+
+	_, err := client.GetRecentTokens(context.Background())
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
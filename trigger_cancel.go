@@ -5,8 +5,8 @@ import (
 )
 
 type CancelOrderRequest struct {
-	Maker            string `json:"maker"`
-	Order            string `json:"order"`
+	Maker            Pubkey `json:"maker"`
+	Order            Pubkey `json:"order"`
 	ComputeUnitPrice string `json:"computeUnitPrice"`
 }
 
@@ -16,6 +16,12 @@ type CancelOrderResponse struct {
 }
 
 func (c *Client) CancelOrder(ctx context.Context, body CancelOrderRequest) (*CancelOrderResponse, error) {
+	if err := requirePubkey("maker", body.Maker); err != nil {
+		return nil, err
+	}
+	if err := requirePubkey("order", body.Order); err != nil {
+		return nil, err
+	}
 	request, err := NewPostRequest(c.Url("/trigger/v1/cancelOrder"), body)
 	if err != nil {
 		return nil, err
@@ -27,3 +33,36 @@ func (c *Client) CancelOrder(ctx context.Context, body CancelOrderRequest) (*Can
 	}
 	return &response, nil
 }
+
+type CancelOrdersRequest struct {
+	Maker            Pubkey   `json:"maker"`
+	Orders           []Pubkey `json:"orders,omitempty"`
+	ComputeUnitPrice string   `json:"computeUnitPrice"`
+}
+
+type CancelOrdersResponse struct {
+	Transactions []string `json:"transactions"`
+	RequestID    string   `json:"requestId"`
+}
+
+// CancelOrders cancels several trigger orders of a maker at once, or all of
+// them when Orders is empty. The API batches the cancellations into groups
+// of five orders, returning one transaction per group.
+func (c *Client) CancelOrders(ctx context.Context, body CancelOrdersRequest) (*CancelOrdersResponse, error) {
+	if err := requirePubkey("maker", body.Maker); err != nil {
+		return nil, err
+	}
+	if err := requirePubkeys("orders", body.Orders); err != nil {
+		return nil, err
+	}
+	request, err := NewPostRequest(c.Url("/trigger/v1/cancelOrders"), body)
+	if err != nil {
+		return nil, err
+	}
+	var response CancelOrdersResponse
+	_, err = c.doCall(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
@@ -20,11 +20,11 @@ func TestCancelOrder(t *testing.T) {
 		body, _ := io.ReadAll(r.Body)
 		var req CancelOrderRequest
 		json.Unmarshal(body, &req)
-		if req.Maker != "maker1" {
-			t.Errorf("expected Maker maker1, got %s", req.Maker)
+		if req.Maker != testUser {
+			t.Errorf("expected Maker %s, got %s", testUser, req.Maker)
 		}
-		if req.Order != "order123" {
-			t.Errorf("expected Order order123, got %s", req.Order)
+		if req.Order != testOrder {
+			t.Errorf("expected Order %s, got %s", testOrder, req.Order)
 		}
 		if req.ComputeUnitPrice != "5000" {
 			t.Errorf("expected ComputeUnitPrice 5000, got %s", req.ComputeUnitPrice)
@@ -40,8 +40,8 @@ func TestCancelOrder(t *testing.T) {
 	client := newTestClient(server.URL)
 
 	result, err := client.CancelOrder(context.Background(), CancelOrderRequest{
-		Maker:            "maker1",
-		Order:            "order123",
+		Maker:            testUser,
+		Order:            testOrder,
 		ComputeUnitPrice: "5000",
 	})
 	if err != nil {
@@ -59,7 +59,83 @@ func TestCancelOrder_Error(t *testing.T) {
 	server := newTestServer(t, errorHandler(http.StatusBadRequest, "order not found"))
 	client := newTestClient(server.URL)
 
-	_, err := client.CancelOrder(context.Background(), CancelOrderRequest{})
+	_, err := client.CancelOrder(context.Background(), CancelOrderRequest{Maker: testUser, Order: testOrder})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestCancelOrders(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST, got %s", r.Method)
+		}
+		if r.URL.Path != "/trigger/v1/cancelOrders" {
+			t.Errorf("expected path /trigger/v1/cancelOrders, got %s", r.URL.Path)
+		}
+
+		body, _ := io.ReadAll(r.Body)
+		var req CancelOrdersRequest
+		json.Unmarshal(body, &req)
+		if req.Maker != testUser {
+			t.Errorf("expected Maker %s, got %s", testUser, req.Maker)
+		}
+		if len(req.Orders) != 6 {
+			t.Errorf("expected 6 orders, got %d", len(req.Orders))
+		}
+
+		resp := CancelOrdersResponse{
+			Transactions: []string{"cancel-tx-1", "cancel-tx-2"},
+			RequestID:    "cancel-req-345",
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+	client := newTestClient(server.URL)
+
+	result, err := client.CancelOrders(context.Background(), CancelOrdersRequest{
+		Maker:            testUser,
+		Orders:           []Pubkey{testPubkey(11), testPubkey(12), testPubkey(13), testPubkey(14), testPubkey(15), testPubkey(16)},
+		ComputeUnitPrice: "auto",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Transactions) != 2 {
+		t.Fatalf("expected 2 transactions, got %d", len(result.Transactions))
+	}
+	if result.Transactions[1] != "cancel-tx-2" {
+		t.Errorf("expected second transaction cancel-tx-2, got %s", result.Transactions[1])
+	}
+	if result.RequestID != "cancel-req-345" {
+		t.Errorf("expected requestId cancel-req-345, got %s", result.RequestID)
+	}
+}
+
+func TestCancelOrders_AllOrders(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var req map[string]any
+		json.Unmarshal(body, &req)
+		if _, ok := req["orders"]; ok {
+			t.Errorf("expected orders to be omitted, got %v", req["orders"])
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(CancelOrdersResponse{})
+	})
+	client := newTestClient(server.URL)
+
+	_, err := client.CancelOrders(context.Background(), CancelOrdersRequest{Maker: testUser})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestCancelOrders_Error(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusBadRequest, "no orders"))
+	client := newTestClient(server.URL)
+
+	_, err := client.CancelOrders(context.Background(), CancelOrdersRequest{Maker: testUser})
 	if err == nil {
 		t.Fatal("expected error")
 	}
@@ -5,31 +5,51 @@ import (
 )
 
 type CreateOrderParams struct {
-	MakingAmount string `json:"makingAmount"`
-	TakingAmount string `json:"takingAmount"`
-    SlippageBps  string `json:"slippageBps,omitempty"`
+	MakingAmount Amount `json:"makingAmount"`
+	TakingAmount Amount `json:"takingAmount"`
+	SlippageBps  Bps    `json:"slippageBps,omitempty,string"`
 	ExpiredAt    string `json:"expiredAt,omitempty"`
-    FeeBps       string `json:"feeBps,omitempty"`
+	FeeBps       Bps    `json:"feeBps,omitempty,string"`
 }
 
 type CreateOrderRequest struct {
-	InputMint        string            `json:"inputMint"`
-	OutputMint       string            `json:"outputMint"`
-	Maker            string            `json:"maker"`
-	Payer            string            `json:"payer"`
+	InputMint        Pubkey            `json:"inputMint"`
+	OutputMint       Pubkey            `json:"outputMint"`
+	Maker            Pubkey            `json:"maker"`
+	Payer            Pubkey            `json:"payer"`
 	Params           CreateOrderParams `json:"params"`
 	ComputeUnitPrice string            `json:"computeUnitPrice"`
-	FeeAccount       string            `json:"feeAccount,omitempty"`
+	FeeAccount       Pubkey            `json:"feeAccount,omitzero"`
 	WrapAndUnwrapSol *bool             `json:"wrapAndUnwrapSol,omitempty"`
 }
 
 type CreateOrderResponse struct {
-	Order       string `json:"order"`
+	Order       Pubkey `json:"order"`
 	Transaction string `json:"transaction"`
 	RequestID   string `json:"requestId"`
 }
 
+func (r CreateOrderRequest) validate() error {
+	for _, field := range []struct {
+		name string
+		key  Pubkey
+	}{
+		{"inputMint", r.InputMint},
+		{"outputMint", r.OutputMint},
+		{"maker", r.Maker},
+		{"payer", r.Payer},
+	} {
+		if err := requirePubkey(field.name, field.key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (c *Client) CreateOrder(ctx context.Context, body CreateOrderRequest) (*CreateOrderResponse, error) {
+	if err := body.validate(); err != nil {
+		return nil, err
+	}
 	request, err := NewPostRequest(c.Url("/trigger/v1/createOrder"), body)
 	if err != nil {
 		return nil, err
@@ -20,24 +20,24 @@ func TestCreateOrder(t *testing.T) {
 		body, _ := io.ReadAll(r.Body)
 		var req CreateOrderRequest
 		json.Unmarshal(body, &req)
-		if req.InputMint != "SOL" {
-			t.Errorf("expected InputMint SOL, got %s", req.InputMint)
+		if req.InputMint != WrappedSOL {
+			t.Errorf("expected InputMint %s, got %s", WrappedSOL, req.InputMint)
 		}
-		if req.OutputMint != "USDC" {
-			t.Errorf("expected OutputMint USDC, got %s", req.OutputMint)
+		if req.OutputMint != USDC {
+			t.Errorf("expected OutputMint %s, got %s", USDC, req.OutputMint)
 		}
-		if req.Maker != "maker1" {
-			t.Errorf("expected Maker maker1, got %s", req.Maker)
+		if req.Maker != testUser {
+			t.Errorf("expected Maker %s, got %s", testUser, req.Maker)
 		}
-		if req.Params.MakingAmount != "1000000" {
+		if req.Params.MakingAmount != NewAmount(1000000) {
 			t.Errorf("expected MakingAmount 1000000, got %s", req.Params.MakingAmount)
 		}
-		if req.Params.TakingAmount != "150000" {
+		if req.Params.TakingAmount != NewAmount(150000) {
 			t.Errorf("expected TakingAmount 150000, got %s", req.Params.TakingAmount)
 		}
 
 		resp := CreateOrderResponse{
-			Order:       "order123",
+			Order:       testOrder,
 			Transaction: "tx456",
 			RequestID:   "req789",
 		}
@@ -47,21 +47,21 @@ func TestCreateOrder(t *testing.T) {
 	client := newTestClient(server.URL)
 
 	result, err := client.CreateOrder(context.Background(), CreateOrderRequest{
-		InputMint:  "SOL",
-		OutputMint: "USDC",
-		Maker:      "maker1",
-		Payer:      "payer1",
+		InputMint:  WrappedSOL,
+		OutputMint: USDC,
+		Maker:      testUser,
+		Payer:      testPayer,
 		Params: CreateOrderParams{
-			MakingAmount: "1000000",
-			TakingAmount: "150000",
+			MakingAmount: NewAmount(1000000),
+			TakingAmount: NewAmount(150000),
 		},
 		ComputeUnitPrice: "1000",
 	})
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
-	if result.Order != "order123" {
-		t.Errorf("expected order order123, got %s", result.Order)
+	if result.Order != testOrder {
+		t.Errorf("expected order %s, got %s", testOrder, result.Order)
 	}
 	if result.Transaction != "tx456" {
 		t.Errorf("expected transaction tx456, got %s", result.Transaction)
@@ -79,10 +79,10 @@ func TestCreateOrder_WithOptionalParams(t *testing.T) {
 		var req CreateOrderRequest
 		json.Unmarshal(body, &req)
 
-		if req.Params.SlippageBps != "50" {
+		if req.Params.SlippageBps != 50 {
 			t.Errorf("expected SlippageBps 50, got %v", req.Params.SlippageBps)
 		}
-		if req.Params.FeeBps != "10" {
+		if req.Params.FeeBps != 10 {
 			t.Errorf("expected FeeBps 10, got %v", req.Params.FeeBps)
 		}
 		if req.Params.ExpiredAt != "1700000000" {
@@ -91,8 +91,8 @@ func TestCreateOrder_WithOptionalParams(t *testing.T) {
 		if req.WrapAndUnwrapSol == nil || !*req.WrapAndUnwrapSol {
 			t.Errorf("expected WrapAndUnwrapSol true, got %v", req.WrapAndUnwrapSol)
 		}
-		if req.FeeAccount != "feeAcct" {
-			t.Errorf("expected FeeAccount feeAcct, got %s", req.FeeAccount)
+		if req.FeeAccount != testFeeAccount {
+			t.Errorf("expected FeeAccount %s, got %s", testFeeAccount, req.FeeAccount)
 		}
 
 		w.Header().Set("Content-Type", "application/json")
@@ -101,19 +101,19 @@ func TestCreateOrder_WithOptionalParams(t *testing.T) {
 	client := newTestClient(server.URL)
 
 	_, err := client.CreateOrder(context.Background(), CreateOrderRequest{
-		InputMint:  "SOL",
-		OutputMint: "USDC",
-		Maker:      "maker1",
-		Payer:      "payer1",
+		InputMint:  WrappedSOL,
+		OutputMint: USDC,
+		Maker:      testUser,
+		Payer:      testPayer,
 		Params: CreateOrderParams{
-			MakingAmount: "1000000",
-			TakingAmount: "150000",
-			SlippageBps:  "50",
-			FeeBps:       "10",
+			MakingAmount: NewAmount(1000000),
+			TakingAmount: NewAmount(150000),
+			SlippageBps:  50,
+			FeeBps:       10,
 			ExpiredAt:    "1700000000",
 		},
 		ComputeUnitPrice: "1000",
-		FeeAccount:       "feeAcct",
+		FeeAccount:       testFeeAccount,
 		WrapAndUnwrapSol: &wrapSol,
 	})
 	if err != nil {
@@ -125,7 +125,7 @@ func TestCreateOrder_Error(t *testing.T) {
 	server := newTestServer(t, errorHandler(http.StatusBadRequest, "invalid order"))
 	client := newTestClient(server.URL)
 
-	_, err := client.CreateOrder(context.Background(), CreateOrderRequest{})
+	_, err := client.CreateOrder(context.Background(), CreateOrderRequest{InputMint: WrappedSOL, OutputMint: USDC, Maker: testUser, Payer: testPayer})
 	if err == nil {
 		t.Fatal("expected error")
 	}
@@ -7,39 +7,39 @@ import (
 )
 
 type GetTriggerOrdersParams struct {
-	User        string
+	User        Pubkey
 	OrderStatus string
-	InputMint   string
-	OutputMint  string
+	InputMint   Pubkey
+	OutputMint  Pubkey
 	Page        int
 }
 
 type TriggerOrder struct {
-	UserPubkey              string   `json:"userPubkey"`
-	OrderKey                string   `json:"orderKey"`
-	InputMint               string   `json:"inputMint"`
-	OutputMint              string   `json:"outputMint"`
-	MakingAmount            string   `json:"makingAmount"`
-	TakingAmount            string   `json:"takingAmount"`
-	RemainingMakingAmount   string   `json:"remainingMakingAmount"`
-	RemainingTakingAmount   string   `json:"remainingTakingAmount"`
-	RawMakingAmount         string   `json:"rawMakingAmount"`
-	RawTakingAmount         string   `json:"rawTakingAmount"`
-	RawRemainingMakingAmount string  `json:"rawRemainingMakingAmount"`
-	RawRemainingTakingAmount string  `json:"rawRemainingTakingAmount"`
-	SlippageBps             string   `json:"slippageBps"`
-	ExpiredAt               *string  `json:"expiredAt"`
-	CreatedAt               string   `json:"createdAt"`
-	UpdatedAt               string   `json:"updatedAt"`
-	Status                  string   `json:"status"`
-	OpenTx                  string   `json:"openTx"`
-	CloseTx                 *string  `json:"closeTx"`
-	ProgramVersion          string   `json:"programVersion"`
-	Trades                  []any    `json:"trades"`
+	UserPubkey               Pubkey  `json:"userPubkey"`
+	OrderKey                 Pubkey  `json:"orderKey"`
+	InputMint                Pubkey  `json:"inputMint"`
+	OutputMint               Pubkey  `json:"outputMint"`
+	MakingAmount             Decimal `json:"makingAmount"`
+	TakingAmount             Decimal `json:"takingAmount"`
+	RemainingMakingAmount    Decimal `json:"remainingMakingAmount"`
+	RemainingTakingAmount    Decimal `json:"remainingTakingAmount"`
+	RawMakingAmount          Amount  `json:"rawMakingAmount"`
+	RawTakingAmount          Amount  `json:"rawTakingAmount"`
+	RawRemainingMakingAmount Amount  `json:"rawRemainingMakingAmount"`
+	RawRemainingTakingAmount Amount  `json:"rawRemainingTakingAmount"`
+	SlippageBps              Bps     `json:"slippageBps,string"`
+	ExpiredAt                *string `json:"expiredAt"`
+	CreatedAt                string  `json:"createdAt"`
+	UpdatedAt                string  `json:"updatedAt"`
+	Status                   string  `json:"status"`
+	OpenTx                   string  `json:"openTx"`
+	CloseTx                  *string `json:"closeTx"`
+	ProgramVersion           string  `json:"programVersion"`
+	Trades                   []any   `json:"trades"`
 }
 
 type GetTriggerOrdersResponse struct {
-	User        string         `json:"user"`
+	User        Pubkey         `json:"user"`
 	OrderStatus string         `json:"orderStatus"`
 	Orders      []TriggerOrder `json:"orders"`
 	TotalPages  int            `json:"totalPages"`
@@ -47,16 +47,19 @@ type GetTriggerOrdersResponse struct {
 }
 
 func (c *Client) GetTriggerOrders(ctx context.Context, params GetTriggerOrdersParams) (*GetTriggerOrdersResponse, error) {
+	if err := requirePubkey("user", params.User); err != nil {
+		return nil, err
+	}
 	queryParams := url.Values{}
 
-	queryParams.Set("user", params.User)
+	queryParams.Set("user", params.User.String())
 	queryParams.Set("orderStatus", params.OrderStatus)
 
-	if params.InputMint != "" {
-		queryParams.Set("inputMint", params.InputMint)
+	if !params.InputMint.IsZero() {
+		queryParams.Set("inputMint", params.InputMint.String())
 	}
-	if params.OutputMint != "" {
-		queryParams.Set("outputMint", params.OutputMint)
+	if !params.OutputMint.IsZero() {
+		queryParams.Set("outputMint", params.OutputMint.String())
 	}
 	if params.Page > 0 {
 		queryParams.Set("page", fmt.Sprintf("%d", params.Page))
@@ -9,11 +9,11 @@ import (
 
 func TestGetTriggerOrders(t *testing.T) {
 	resp := GetTriggerOrdersResponse{
-		User:        "user1",
+		User:        testUser,
 		OrderStatus: "open",
 		Orders: []TriggerOrder{
-			{UserPubkey: "user1", OrderKey: "order1", InputMint: "SOL", OutputMint: "USDC", MakingAmount: "1.0", TakingAmount: "200.0", Status: "Open"},
-			{UserPubkey: "user1", OrderKey: "order2", InputMint: "SOL", OutputMint: "USDC", MakingAmount: "2.0", TakingAmount: "400.0", Status: "Filled"},
+			{UserPubkey: testUser, OrderKey: testOrder, InputMint: WrappedSOL, OutputMint: USDC, MakingAmount: testDecimal("1.0"), TakingAmount: testDecimal("200.0"), Status: "Open"},
+			{UserPubkey: testUser, OrderKey: testOrder2, InputMint: WrappedSOL, OutputMint: USDC, MakingAmount: testDecimal("2.0"), TakingAmount: testDecimal("400.0"), Status: "Filled"},
 		},
 		TotalPages: 2,
 		Page:       1,
@@ -27,8 +27,8 @@ func TestGetTriggerOrders(t *testing.T) {
 			t.Errorf("expected path /trigger/v1/getTriggerOrders, got %s", r.URL.Path)
 		}
 		q := r.URL.Query()
-		if q.Get("user") != "user1" {
-			t.Errorf("expected user=user1, got %s", q.Get("user"))
+		if q.Get("user") != testUser.String() {
+			t.Errorf("expected user=%s, got %s", testUser, q.Get("user"))
 		}
 		if q.Get("orderStatus") != "open" {
 			t.Errorf("expected orderStatus=open, got %s", q.Get("orderStatus"))
@@ -39,7 +39,7 @@ func TestGetTriggerOrders(t *testing.T) {
 	client := newTestClient(server.URL)
 
 	result, err := client.GetTriggerOrders(context.Background(), GetTriggerOrdersParams{
-		User:        "user1",
+		User:        testUser,
 		OrderStatus: "open",
 	})
 	if err != nil {
@@ -59,11 +59,11 @@ func TestGetTriggerOrders(t *testing.T) {
 func TestGetTriggerOrders_WithOptionalParams(t *testing.T) {
 	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
 		q := r.URL.Query()
-		if q.Get("inputMint") != "SOL" {
-			t.Errorf("expected inputMint=SOL, got %s", q.Get("inputMint"))
+		if q.Get("inputMint") != WrappedSOL.String() {
+			t.Errorf("expected inputMint=%s, got %s", WrappedSOL, q.Get("inputMint"))
 		}
-		if q.Get("outputMint") != "USDC" {
-			t.Errorf("expected outputMint=USDC, got %s", q.Get("outputMint"))
+		if q.Get("outputMint") != USDC.String() {
+			t.Errorf("expected outputMint=%s, got %s", USDC, q.Get("outputMint"))
 		}
 		if q.Get("page") != "2" {
 			t.Errorf("expected page=2, got %s", q.Get("page"))
@@ -74,10 +74,10 @@ func TestGetTriggerOrders_WithOptionalParams(t *testing.T) {
 	client := newTestClient(server.URL)
 
 	_, err := client.GetTriggerOrders(context.Background(), GetTriggerOrdersParams{
-		User:        "user1",
+		User:        testUser,
 		OrderStatus: "open",
-		InputMint:   "SOL",
-		OutputMint:  "USDC",
+		InputMint:   WrappedSOL,
+		OutputMint:  USDC,
 		Page:        2,
 	})
 	if err != nil {
@@ -103,7 +103,7 @@ func TestGetTriggerOrders_NoOptionalParams(t *testing.T) {
 	client := newTestClient(server.URL)
 
 	_, err := client.GetTriggerOrders(context.Background(), GetTriggerOrdersParams{
-		User:        "user1",
+		User:        testUser,
 		OrderStatus: "open",
 	})
 	if err != nil {
@@ -115,7 +115,7 @@ func TestGetTriggerOrders_Error(t *testing.T) {
 	server := newTestServer(t, errorHandler(http.StatusBadRequest, "missing user"))
 	client := newTestClient(server.URL)
 
-	_, err := client.GetTriggerOrders(context.Background(), GetTriggerOrdersParams{})
+	_, err := client.GetTriggerOrders(context.Background(), GetTriggerOrdersParams{User: testUser})
 	if err == nil {
 		t.Fatal("expected error")
 	}
@@ -2,6 +2,7 @@ package jupiter
 
 type TokenStats struct {
 	PriceChange       *float64 `json:"priceChange,omitempty"`
+	HolderChange      *float64 `json:"holderChange,omitempty"`
 	LiquidityChange   *float64 `json:"liquidityChange,omitempty"`
 	VolumeChange      *float64 `json:"volumeChange,omitempty"`
 	BuyVolume         *float64 `json:"buyVolume,omitempty"`
@@ -16,36 +17,39 @@ type TokenStats struct {
 }
 
 type Audit struct {
+	IsSus                   *bool    `json:"isSus,omitempty"`
 	MintAuthorityDisabled   *bool    `json:"mintAuthorityDisabled,omitempty"`
 	FreezeAuthorityDisabled *bool    `json:"freezeAuthorityDisabled,omitempty"`
 	TopHoldersPercentage    *float64 `json:"topHoldersPercentage,omitempty"`
+	DevBalancePercentage    *float64 `json:"devBalancePercentage,omitempty"`
+	DevMints                *int     `json:"devMints,omitempty"`
 }
 
 type FirstPool struct {
-	ID        string `json:"id"`
+	ID        Pubkey `json:"id"`
 	CreatedAt string `json:"createdAt"`
 }
 
 type SwapInfo struct {
-	AmmKey     string `json:"ammKey"`
+	AmmKey     Pubkey `json:"ammKey"`
 	Label      string `json:"label,omitempty"`
-	InputMint  string `json:"inputMint"`
-	OutputMint string `json:"outputMint"`
-	InAmount   string `json:"inAmount"`
-	OutAmount  string `json:"outAmount"`
-	FeeAmount  string `json:"feeAmount,omitempty"`
-	FeeMint    string `json:"feeMint,omitempty"`
+	InputMint  Pubkey `json:"inputMint"`
+	OutputMint Pubkey `json:"outputMint"`
+	InAmount   Amount `json:"inAmount"`
+	OutAmount  Amount `json:"outAmount"`
+	FeeAmount  Amount `json:"feeAmount"`
+	FeeMint    Pubkey `json:"feeMint"`
 }
 
 type RoutePlanStep struct {
 	SwapInfo SwapInfo `json:"swapInfo"`
 	Percent  *int     `json:"percent,omitempty"`
-	Bps      *int     `json:"bps,omitempty"`
+	Bps      *Bps     `json:"bps,omitempty"`
 }
 
 type PlatformFee struct {
-	Amount string `json:"amount"`
-	FeeBps int    `json:"feeBps"`
+	Amount Amount `json:"amount"`
+	FeeBps Bps    `json:"feeBps"`
 }
 
 type ExecuteRequest struct {
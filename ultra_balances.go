@@ -0,0 +1,30 @@
+package jupiter
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type TokenBalance struct {
+	Amount   Amount  `json:"amount"`
+	UIAmount Decimal `json:"uiAmount"`
+	Slot     int64   `json:"slot"`
+	IsFrozen bool    `json:"isFrozen"`
+}
+
+type BalancesResponse map[string]TokenBalance
+
+func (c *Client) GetBalances(ctx context.Context, address Pubkey) (BalancesResponse, error) {
+	if err := requirePubkey("address", address); err != nil {
+		return nil, err
+	}
+	endpoint := fmt.Sprintf("/ultra/v1/balances/%s", address)
+	request := NewRequest(c.Url(endpoint), url.Values{})
+	var response BalancesResponse
+	_, err := c.doCall(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
@@ -0,0 +1,44 @@
+package jupiter
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetBalances(t *testing.T) {
+	balances := BalancesResponse{
+		"SOL":     {Amount: NewAmount(1500000000), UIAmount: testDecimal("1.5"), Slot: 324307186, IsFrozen: false},
+		"USDC111": {Amount: NewAmount(2500000), UIAmount: testDecimal("2.5"), Slot: 324307186, IsFrozen: true},
+	}
+
+	server := newTestServer(t, jsonHandler(t, http.MethodGet, "/ultra/v1/balances/"+testUser.String(), balances))
+	client := newTestClient(server.URL)
+
+	result, err := client.GetBalances(context.Background(), testUser)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 2 {
+		t.Fatalf("expected 2 balances, got %d", len(result))
+	}
+	if result["SOL"].Amount != NewAmount(1500000000) {
+		t.Errorf("expected SOL amount 1500000000, got %s", result["SOL"].Amount)
+	}
+	if result["SOL"].UIAmount.String() != "1.5" {
+		t.Errorf("expected SOL uiAmount 1.5, got %s", result["SOL"].UIAmount)
+	}
+	if !result["USDC111"].IsFrozen {
+		t.Error("expected USDC111 to be frozen")
+	}
+}
+
+func TestGetBalances_Error(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusBadRequest, "invalid address"))
+	client := newTestClient(server.URL)
+
+	_, err := client.GetBalances(context.Background(), testUser)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
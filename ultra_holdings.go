@@ -0,0 +1,39 @@
+package jupiter
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+type TokenAccountHolding struct {
+	Account                  Pubkey  `json:"account"`
+	Amount                   Amount  `json:"amount"`
+	UIAmount                 Decimal `json:"uiAmount"`
+	UIAmountString           string  `json:"uiAmountString"`
+	IsFrozen                 bool    `json:"isFrozen"`
+	IsAssociatedTokenAccount bool    `json:"isAssociatedTokenAccount"`
+	Decimals                 int     `json:"decimals"`
+	ProgramID                Pubkey  `json:"programId"`
+}
+
+type HoldingsResponse struct {
+	Amount         Amount                           `json:"amount"`
+	UIAmount       Decimal                          `json:"uiAmount"`
+	UIAmountString string                           `json:"uiAmountString"`
+	Tokens         map[Pubkey][]TokenAccountHolding `json:"tokens"`
+}
+
+func (c *Client) GetHoldings(ctx context.Context, address Pubkey) (*HoldingsResponse, error) {
+	if err := requirePubkey("address", address); err != nil {
+		return nil, err
+	}
+	endpoint := fmt.Sprintf("/ultra/v1/holdings/%s", address)
+	request := NewRequest(c.Url(endpoint), url.Values{})
+	var response HoldingsResponse
+	_, err := c.doCall(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
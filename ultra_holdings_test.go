@@ -0,0 +1,63 @@
+package jupiter
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestGetHoldings(t *testing.T) {
+	holdings := HoldingsResponse{
+		Amount:         NewAmount(1500000000),
+		UIAmount:       testDecimal("1.5"),
+		UIAmountString: "1.5",
+		Tokens: map[Pubkey][]TokenAccountHolding{
+			USDC: {
+				{
+					Account:                  testAccount,
+					Amount:                   NewAmount(2500000),
+					UIAmount:                 testDecimal("2.5"),
+					UIAmountString:           "2.5",
+					IsAssociatedTokenAccount: true,
+					Decimals:                 6,
+					ProgramID:                TokenProgram,
+				},
+				{Account: testDest, Amount: NewAmount(1), Decimals: 6, IsFrozen: true},
+			},
+		},
+	}
+
+	server := newTestServer(t, jsonHandler(t, http.MethodGet, "/ultra/v1/holdings/"+testUser.String(), holdings))
+	client := newTestClient(server.URL)
+
+	result, err := client.GetHoldings(context.Background(), testUser)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.UIAmountString != "1.5" {
+		t.Errorf("expected uiAmountString 1.5, got %s", result.UIAmountString)
+	}
+	accounts := result.Tokens[USDC]
+	if len(accounts) != 2 {
+		t.Fatalf("expected 2 token accounts, got %d", len(accounts))
+	}
+	if !accounts[0].IsAssociatedTokenAccount {
+		t.Error("expected first account to be associated token account")
+	}
+	if accounts[0].Decimals != 6 {
+		t.Errorf("expected decimals 6, got %d", accounts[0].Decimals)
+	}
+	if !accounts[1].IsFrozen {
+		t.Error("expected second account to be frozen")
+	}
+}
+
+func TestGetHoldings_Error(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusInternalServerError, "error"))
+	client := newTestClient(server.URL)
+
+	_, err := client.GetHoldings(context.Background(), testUser)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
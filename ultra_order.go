@@ -0,0 +1,107 @@
+package jupiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+type UltraOrderParams struct {
+	InputMint       Pubkey
+	OutputMint      Pubkey
+	Amount          Amount
+	Taker           Pubkey
+	ReferralAccount Pubkey
+	ReferralFee     int
+	ExcludeRouters  string
+	ExcludeDexes    string
+	Payer           Pubkey
+}
+
+type UltraOrderResponse struct {
+	Mode                      string          `json:"mode,omitempty"`
+	InputMint                 Pubkey          `json:"inputMint"`
+	OutputMint                Pubkey          `json:"outputMint"`
+	InAmount                  Amount          `json:"inAmount"`
+	OutAmount                 Amount          `json:"outAmount"`
+	OtherAmountThreshold      Amount          `json:"otherAmountThreshold"`
+	SwapMode                  string          `json:"swapMode"`
+	SlippageBps               Bps             `json:"slippageBps"`
+	PriceImpactPct            string          `json:"priceImpactPct,omitempty"`
+	RoutePlan                 []RoutePlanStep `json:"routePlan"`
+	FeeMint                   Pubkey          `json:"feeMint,omitzero"`
+	FeeBps                    Bps             `json:"feeBps"`
+	PlatformFee               *PlatformFee    `json:"platformFee,omitempty"`
+	Taker                     Pubkey          `json:"taker"`
+	Gasless                   bool            `json:"gasless"`
+	SignatureFeeLamports      int64           `json:"signatureFeeLamports"`
+	PrioritizationFeeLamports int64           `json:"prioritizationFeeLamports"`
+	RentFeeLamports           int64           `json:"rentFeeLamports"`
+	SwapType                  string          `json:"swapType"`
+	Router                    string          `json:"router"`
+	Transaction               *string         `json:"transaction"`
+	RequestID                 string          `json:"requestId"`
+	InUsdValue                *float64        `json:"inUsdValue,omitempty"`
+	OutUsdValue               *float64        `json:"outUsdValue,omitempty"`
+	SwapUsdValue              *float64        `json:"swapUsdValue,omitempty"`
+	PriceImpact               *float64        `json:"priceImpact,omitempty"`
+	TotalTime                 *float64        `json:"totalTime,omitempty"`
+	ExpireAt                  string          `json:"expireAt,omitempty"`
+	ErrorCode                 *int            `json:"errorCode,omitempty"`
+	ErrorMessage              string          `json:"errorMessage,omitempty"`
+}
+
+func (p UltraOrderParams) validate() error {
+	if err := requirePubkey("inputMint", p.InputMint); err != nil {
+		return err
+	}
+	if err := requirePubkey("outputMint", p.OutputMint); err != nil {
+		return err
+	}
+	if p.InputMint == p.OutputMint {
+		return &FieldError{Field: "outputMint", Err: errors.New("must differ from inputMint")}
+	}
+	if p.Amount.IsZero() {
+		return &FieldError{Field: "amount", Err: errors.New("must be greater than zero")}
+	}
+	return nil
+}
+
+func (c *Client) GetUltraOrder(ctx context.Context, params UltraOrderParams) (*UltraOrderResponse, error) {
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+	queryParams := url.Values{}
+
+	queryParams.Set("inputMint", params.InputMint.String())
+	queryParams.Set("outputMint", params.OutputMint.String())
+	queryParams.Set("amount", params.Amount.String())
+
+	if !params.Taker.IsZero() {
+		queryParams.Set("taker", params.Taker.String())
+	}
+	if !params.ReferralAccount.IsZero() {
+		queryParams.Set("referralAccount", params.ReferralAccount.String())
+	}
+	if params.ReferralFee > 0 {
+		queryParams.Set("referralFee", fmt.Sprintf("%d", params.ReferralFee))
+	}
+	if params.ExcludeRouters != "" {
+		queryParams.Set("excludeRouters", params.ExcludeRouters)
+	}
+	if params.ExcludeDexes != "" {
+		queryParams.Set("excludeDexes", params.ExcludeDexes)
+	}
+	if !params.Payer.IsZero() {
+		queryParams.Set("payer", params.Payer.String())
+	}
+
+	request := NewRequest(c.Url("/ultra/v1/order"), queryParams)
+	var response UltraOrderResponse
+	_, err := c.doHedgedCall(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
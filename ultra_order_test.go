@@ -0,0 +1,173 @@
+package jupiter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestGetUltraOrder(t *testing.T) {
+	tx := "unsigned-tx-123"
+	order := UltraOrderResponse{
+		Mode:                 "ultra",
+		InputMint:            WrappedSOL,
+		OutputMint:           USDC,
+		InAmount:             NewAmount(1000000000),
+		OutAmount:            NewAmount(15025000),
+		OtherAmountThreshold: NewAmount(15000000),
+		SwapMode:             "ExactIn",
+		SlippageBps:          50,
+		RoutePlan: []RoutePlanStep{
+			{
+				SwapInfo: SwapInfo{
+					AmmKey:     testAMM,
+					Label:      "Meteora",
+					InputMint:  WrappedSOL,
+					OutputMint: USDC,
+					InAmount:   NewAmount(1000000000),
+					OutAmount:  NewAmount(15025000),
+				},
+			},
+		},
+		FeeBps:                    5,
+		Taker:                     testUser,
+		Gasless:                   true,
+		PrioritizationFeeLamports: 10000,
+		SwapType:                  "aggregator",
+		Router:                    "iris",
+		Transaction:               &tx,
+		RequestID:                 "req-456",
+	}
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/ultra/v1/order" {
+			t.Errorf("expected path /ultra/v1/order, got %s", r.URL.Path)
+		}
+		q := r.URL.Query()
+		if q.Get("inputMint") != WrappedSOL.String() {
+			t.Errorf("expected inputMint=%s, got %s", WrappedSOL, q.Get("inputMint"))
+		}
+		if q.Get("outputMint") != USDC.String() {
+			t.Errorf("expected outputMint=%s, got %s", USDC, q.Get("outputMint"))
+		}
+		if q.Get("amount") != "1000000000" {
+			t.Errorf("expected amount=1000000000, got %s", q.Get("amount"))
+		}
+		if q.Get("taker") != testUser.String() {
+			t.Errorf("expected taker=%s, got %s", testUser, q.Get("taker"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(order)
+	})
+	client := newTestClient(server.URL)
+
+	result, err := client.GetUltraOrder(context.Background(), UltraOrderParams{
+		InputMint:  WrappedSOL,
+		OutputMint: USDC,
+		Amount:     NewAmount(1000000000),
+		Taker:      testUser,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.RequestID != "req-456" {
+		t.Errorf("expected requestId req-456, got %s", result.RequestID)
+	}
+	if result.Transaction == nil || *result.Transaction != "unsigned-tx-123" {
+		t.Errorf("expected transaction unsigned-tx-123, got %v", result.Transaction)
+	}
+	if result.Router != "iris" {
+		t.Errorf("expected router iris, got %s", result.Router)
+	}
+	if !result.Gasless {
+		t.Error("expected gasless true")
+	}
+	if len(result.RoutePlan) != 1 {
+		t.Fatalf("expected 1 route plan step, got %d", len(result.RoutePlan))
+	}
+	if result.RoutePlan[0].SwapInfo.Label != "Meteora" {
+		t.Errorf("expected label Meteora, got %s", result.RoutePlan[0].SwapInfo.Label)
+	}
+}
+
+func TestGetUltraOrder_AllParams(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("referralAccount") != testReferral.String() {
+			t.Errorf("expected referralAccount=%s, got %s", testReferral, q.Get("referralAccount"))
+		}
+		if q.Get("referralFee") != "100" {
+			t.Errorf("expected referralFee=100, got %s", q.Get("referralFee"))
+		}
+		if q.Get("excludeRouters") != "dflow,okx" {
+			t.Errorf("expected excludeRouters=dflow,okx, got %s", q.Get("excludeRouters"))
+		}
+		if q.Get("excludeDexes") != "Orca" {
+			t.Errorf("expected excludeDexes=Orca, got %s", q.Get("excludeDexes"))
+		}
+		if q.Get("payer") != testPayer.String() {
+			t.Errorf("expected payer=%s, got %s", testPayer, q.Get("payer"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(UltraOrderResponse{})
+	})
+	client := newTestClient(server.URL)
+
+	_, err := client.GetUltraOrder(context.Background(), UltraOrderParams{
+		InputMint:       WrappedSOL,
+		OutputMint:      USDC,
+		Amount:          NewAmount(1000),
+		Taker:           testUser,
+		ReferralAccount: testReferral,
+		ReferralFee:     100,
+		ExcludeRouters:  "dflow,okx",
+		ExcludeDexes:    "Orca",
+		Payer:           testPayer,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestGetUltraOrder_NoTaker(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Has("taker") {
+			t.Errorf("expected no taker param, got %s", r.URL.Query().Get("taker"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"inputMint":"So11111111111111111111111111111111111111112","outputMint":"EPjFWdd5AufqSSqeM2qN1xzybapC8G4wEGGkZwyTDt1v","transaction":null,"taker":null,"requestId":"req-1","errorCode":1,"errorMessage":"Insufficient funds"}`))
+	})
+	client := newTestClient(server.URL)
+
+	result, err := client.GetUltraOrder(context.Background(), UltraOrderParams{
+		InputMint:  WrappedSOL,
+		OutputMint: USDC,
+		Amount:     NewAmount(1000),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Transaction != nil {
+		t.Errorf("expected nil transaction, got %s", *result.Transaction)
+	}
+	if result.ErrorCode == nil || *result.ErrorCode != 1 {
+		t.Errorf("expected errorCode 1, got %v", result.ErrorCode)
+	}
+	if result.ErrorMessage != "Insufficient funds" {
+		t.Errorf("expected errorMessage Insufficient funds, got %s", result.ErrorMessage)
+	}
+}
+
+func TestGetUltraOrder_Error(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusBadRequest, "missing params"))
+	client := newTestClient(server.URL)
+
+	_, err := client.GetUltraOrder(context.Background(), UltraOrderParams{})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,22 @@
+package jupiter
+
+import (
+	"context"
+	"net/url"
+)
+
+// SearchUltraTokens searches tokens through the Ultra API. It decodes into
+// the same TokenV2 model as SearchTokens, so callers can switch between the
+// two endpoints freely.
+func (c *Client) SearchUltraTokens(ctx context.Context, params SearchTokensParams) ([]TokenV2, error) {
+	queryParams := url.Values{}
+	queryParams.Set("query", params.Query)
+
+	request := NewRequest(c.Url("/ultra/v1/search"), queryParams)
+	var response []TokenV2
+	_, err := c.doCall(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
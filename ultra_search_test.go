@@ -0,0 +1,81 @@
+package jupiter
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestSearchUltraTokens(t *testing.T) {
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/ultra/v1/search" {
+			t.Errorf("expected path /ultra/v1/search, got %s", r.URL.Path)
+		}
+		if r.URL.Query().Get("query") != "JUP" {
+			t.Errorf("expected query=JUP, got %s", r.URL.Query().Get("query"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{
+			"id": "JUPyiwrYJFskUPiHa7hkeR8VUtAeFoSYbKedZNsDvCN",
+			"name": "Jupiter",
+			"symbol": "JUP",
+			"decimals": 6,
+			"twitter": "https://twitter.com/JupiterExchange",
+			"website": "https://jup.ag",
+			"dev": "` + testUser.String() + `",
+			"launchpad": "met-dbc",
+			"audit": {"isSus": false, "mintAuthorityDisabled": true, "devBalancePercentage": 0.5, "devMints": 3},
+			"stats24h": {"priceChange": 1.2, "holderChange": 0.4},
+			"isVerified": true,
+			"ctLikes": 120,
+			"smartCtLikes": 30
+		}]`))
+	})
+	client := newTestClient(server.URL)
+
+	result, err := client.SearchUltraTokens(context.Background(), SearchTokensParams{Query: "JUP"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != 1 {
+		t.Fatalf("expected 1 token, got %d", len(result))
+	}
+	token := result[0]
+	if token.Symbol != "JUP" {
+		t.Errorf("expected symbol JUP, got %s", token.Symbol)
+	}
+	if token.ID.String() != "JUPyiwrYJFskUPiHa7hkeR8VUtAeFoSYbKedZNsDvCN" || token.Dev != testUser {
+		t.Errorf("unexpected id %s or dev %s", token.ID, token.Dev)
+	}
+	if token.Website != "https://jup.ag" {
+		t.Errorf("expected website https://jup.ag, got %s", token.Website)
+	}
+	if token.Launchpad != "met-dbc" {
+		t.Errorf("expected launchpad met-dbc, got %s", token.Launchpad)
+	}
+	if token.Audit == nil || token.Audit.IsSus == nil || *token.Audit.IsSus {
+		t.Errorf("expected audit.isSus false, got %v", token.Audit)
+	}
+	if token.Audit.DevMints == nil || *token.Audit.DevMints != 3 {
+		t.Errorf("expected audit.devMints 3, got %v", token.Audit.DevMints)
+	}
+	if token.Stats24h == nil || token.Stats24h.HolderChange == nil || *token.Stats24h.HolderChange != 0.4 {
+		t.Errorf("expected stats24h.holderChange 0.4, got %v", token.Stats24h)
+	}
+	if token.SmartCtLikes == nil || *token.SmartCtLikes != 30 {
+		t.Errorf("expected smartCtLikes 30, got %v", token.SmartCtLikes)
+	}
+}
+
+func TestSearchUltraTokens_Error(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusBadRequest, "bad request"))
+	client := newTestClient(server.URL)
+
+	_, err := client.SearchUltraTokens(context.Background(), SearchTokensParams{Query: ""})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}
@@ -0,0 +1,35 @@
+package jupiter
+
+import (
+	"context"
+	"net/url"
+)
+
+type ShieldWarning struct {
+	Type     string `json:"type"`
+	Message  string `json:"message"`
+	Severity string `json:"severity"`
+}
+
+type ShieldResponse struct {
+	Warnings map[Pubkey][]ShieldWarning `json:"warnings"`
+}
+
+func (c *Client) GetShield(ctx context.Context, mints []Pubkey) (*ShieldResponse, error) {
+	if len(mints) == 0 {
+		return nil, &FieldError{Field: "mints", Err: ErrMissingPubkey}
+	}
+	if err := requirePubkeys("mints", mints); err != nil {
+		return nil, err
+	}
+	queryParams := url.Values{}
+	queryParams.Set("mints", joinPubkeys(mints))
+
+	request := NewRequest(c.Url("/ultra/v1/shield"), queryParams)
+	var response ShieldResponse
+	_, err := c.doCall(ctx, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
@@ -0,0 +1,59 @@
+package jupiter
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func TestGetShield(t *testing.T) {
+	shield := ShieldResponse{
+		Warnings: map[Pubkey][]ShieldWarning{
+			WrappedSOL: {
+				{Type: "NOT_VERIFIED", Message: "This token is not verified", Severity: "info"},
+				{Type: "HAS_FREEZE_AUTHORITY", Message: "The authority can freeze your funds", Severity: "critical"},
+			},
+			USDC: {},
+		},
+	}
+
+	server := newTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("expected GET, got %s", r.Method)
+		}
+		if r.URL.Path != "/ultra/v1/shield" {
+			t.Errorf("expected path /ultra/v1/shield, got %s", r.URL.Path)
+		}
+		if want := WrappedSOL.String() + "," + USDC.String(); r.URL.Query().Get("mints") != want {
+			t.Errorf("expected mints=%s, got %s", want, r.URL.Query().Get("mints"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(shield)
+	})
+	client := newTestClient(server.URL)
+
+	result, err := client.GetShield(context.Background(), []Pubkey{WrappedSOL, USDC})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result.Warnings[WrappedSOL]) != 2 {
+		t.Fatalf("expected 2 warnings for wrapped SOL, got %d", len(result.Warnings[WrappedSOL]))
+	}
+	if result.Warnings[WrappedSOL][1].Severity != "critical" {
+		t.Errorf("expected severity critical, got %s", result.Warnings[WrappedSOL][1].Severity)
+	}
+	if len(result.Warnings[USDC]) != 0 {
+		t.Errorf("expected no warnings for USDC, got %d", len(result.Warnings[USDC]))
+	}
+}
+
+func TestGetShield_Error(t *testing.T) {
+	server := newTestServer(t, errorHandler(http.StatusBadRequest, "missing mints"))
+	client := newTestClient(server.URL)
+
+	_, err := client.GetShield(context.Background(), []Pubkey{WrappedSOL})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+}